@@ -12,7 +12,9 @@ import (
 )
 
 var (
-	cannonCfgFile string
+	cannonCfgFile     string
+	cannonDryRun      bool
+	cannonPrintConfig bool
 )
 
 // cannonCmd represents the cannon command
@@ -33,18 +35,46 @@ var cannonCmd = &cobra.Command{
 
 		log.Info("Config loaded")
 
+		if cannonDryRun {
+			config.DryRun = true
+		}
+
+		if cannonPrintConfig {
+			if err := config.Validate(); err != nil {
+				log.WithError(err).Fatal("Invalid config")
+			}
+
+			out, err := config.RedactedYAML()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if _, err := os.Stdout.Write(out); err != nil {
+				log.Fatal(err)
+			}
+
+			return
+		}
+
 		logLevel, err := logrus.ParseLevel(config.LoggingLevel)
 		if err != nil {
 			log.WithField("logLevel", config.LoggingLevel).Fatal("invalid logging level")
 		}
 
 		log.SetLevel(logLevel)
+		log.SetFormatter(config.LogFormat.Formatter())
+
+		reloadConfig := func() (*cannon.Config, error) {
+			return loadcannonConfigFromFile(cannonCfgFile)
+		}
 
 		cannon, err := cannon.New(cmd.Context(), log, config)
 		if err != nil {
 			log.Fatal(err)
 		}
 
+		cannon.SetConfigLoader(reloadConfig)
+
 		if err := cannon.Start(cmd.Context()); err != nil {
 			log.Fatal(err)
 		}
@@ -57,6 +87,8 @@ func init() {
 	rootCmd.AddCommand(cannonCmd)
 
 	cannonCmd.Flags().StringVar(&cannonCfgFile, "config", "cannon.yaml", "config file (default is cannon.yaml)")
+	cannonCmd.Flags().BoolVar(&cannonDryRun, "dry-run", false, "derive events without sending them to outputs or persisting locations to the coordinator")
+	cannonCmd.Flags().BoolVar(&cannonPrintConfig, "print-config", false, "validate the config, print the effective config with secrets redacted and exit")
 }
 
 func loadcannonConfigFromFile(file string) (*cannon.Config, error) {
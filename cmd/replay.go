@@ -0,0 +1,103 @@
+//nolint:dupl // disable duplicate code warning for cmds
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/creasty/defaults"
+	"github.com/ethpandaops/xatu/pkg/replay"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v3"
+)
+
+var (
+	replayCfgFile string
+	replayFiles   []string
+	replayRate    int
+)
+
+// replayCmd represents the replay command
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Replays events captured by the file output.",
+	Long: `Replays decorated events from newline-delimited JSON files written by
+	the file output, sending them to the configured outputs.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		initCommon()
+
+		log.WithField("location", replayCfgFile).Info("Loading config")
+
+		config, err := loadReplayConfigFromFile(replayCfgFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("Config loaded")
+
+		if len(replayFiles) > 0 {
+			config.Files = replayFiles
+		}
+
+		if cmd.Flags().Changed("rate") {
+			config.EventsPerSecond = replayRate
+		}
+
+		logLevel, err := logrus.ParseLevel(config.LoggingLevel)
+		if err != nil {
+			log.WithField("logLevel", config.LoggingLevel).Fatal("invalid logging level")
+		}
+
+		log.SetLevel(logLevel)
+
+		r, err := replay.New(log, config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+
+		if err := r.Start(ctx); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("Xatu replay exited - cya!")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(replayCmd)
+
+	replayCmd.Flags().StringVar(&replayCfgFile, "config", "replay.yaml", "config file (default is replay.yaml)")
+	replayCmd.Flags().StringSliceVar(&replayFiles, "file", nil, "file to replay, can be repeated. Overrides files in the config")
+	replayCmd.Flags().IntVar(&replayRate, "rate", 0, "events to replay per second, 0 is unlimited. Overrides eventsPerSecond in the config")
+}
+
+func loadReplayConfigFromFile(file string) (*replay.Config, error) {
+	if file == "" {
+		file = "replay.yaml"
+	}
+
+	config := &replay.Config{}
+
+	if err := defaults.Set(config); err != nil {
+		return nil, err
+	}
+
+	yamlFile, err := os.ReadFile(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	type plain replay.Config
+
+	if err := yaml.Unmarshal(yamlFile, (*plain)(config)); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
@@ -38,6 +38,7 @@ var serverCmd = &cobra.Command{
 		}
 
 		log.SetLevel(logLevel)
+		log.SetFormatter(config.LogFormat.Formatter())
 
 		server, err := server.NewXatu(cmd.Context(), log, config)
 		if err != nil {
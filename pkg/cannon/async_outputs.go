@@ -0,0 +1,206 @@
+package cannon
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/output"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+)
+
+// asyncRetryMaxInterval caps the delay between attempts to hand queued events to a failing sink.
+const asyncRetryMaxInterval = 30 * time.Second
+
+// errAsyncSinkStopped is returned to derivers that emit events after the sink's queue has been closed.
+var errAsyncSinkStopped = errors.New("async sink has been stopped")
+
+type AsyncOutputsConfig struct {
+	// Enabled queues events per output instead of handing them over in the deriver's goroutine
+	Enabled bool `yaml:"enabled" default:"false"`
+	// QueueSize is the number of event batches that can be queued for each output. Derivers wait for room once
+	// an output's queue is full.
+	QueueSize int `yaml:"queueSize" default:"100"`
+	// Workers is the number of goroutines draining each output's queue
+	Workers int `yaml:"workers" default:"1"`
+}
+
+func (c *AsyncOutputsConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.QueueSize <= 0 {
+		return errors.New("queueSize must be greater than 0")
+	}
+
+	if c.Workers <= 0 {
+		return errors.New("workers must be greater than 0")
+	}
+
+	return nil
+}
+
+// asyncSink is the bounded queue of event batches waiting to be handed to a sink.
+type asyncSink struct {
+	sink  output.Sink
+	queue chan []*xatu.DecoratedEvent
+	wg    sync.WaitGroup
+
+	// mu guards closing queue against concurrent sends. stopping is closed before mu is taken so that senders
+	// blocked on a full queue let go of the read lock.
+	mu       sync.RWMutex
+	closed   bool
+	stopping chan struct{}
+}
+
+// startAsyncSinks starts the workers draining each sink's queue. The workers keep delivering until the queues are
+// closed by stopAsyncSinks.
+func (c *Cannon) startAsyncSinks() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c.asyncSinksCancel = cancel
+	c.asyncSinks = make([]*asyncSink, len(c.sinks))
+
+	for i, sink := range c.sinks {
+		q := &asyncSink{
+			sink:     sink,
+			queue:    make(chan []*xatu.DecoratedEvent, c.Config.AsyncOutputs.QueueSize),
+			stopping: make(chan struct{}),
+		}
+
+		for w := 0; w < c.Config.AsyncOutputs.Workers; w++ {
+			q.wg.Add(1)
+
+			go func() {
+				defer q.wg.Done()
+
+				c.drainAsyncSink(ctx, q)
+			}()
+		}
+
+		c.asyncSinks[i] = q
+	}
+}
+
+func (c *Cannon) drainAsyncSink(ctx context.Context, q *asyncSink) {
+	for events := range q.queue {
+		c.deliverQueued(ctx, q, events)
+	}
+}
+
+// deliverQueued hands the events to the sink, retrying until it succeeds. The deriver's location has already moved
+// past queued events, so they are only given up on once ctx is cancelled at shutdown. Until then a failing sink
+// fills its queue and holds the derivers back.
+func (c *Cannon) deliverQueued(ctx context.Context, q *asyncSink, events []*xatu.DecoratedEvent) {
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = asyncRetryMaxInterval
+	bo.MaxElapsedTime = 0
+
+	err := backoff.RetryNotify(func() error {
+		return c.sendToSink(ctx, q.sink, events)
+	}, backoff.WithContext(bo, ctx), func(err error, next time.Duration) {
+		c.log.
+			WithError(err).
+			WithField("sink", q.sink.Name()).
+			WithField("events", len(events)).
+			WithField("next_attempt", next).
+			Warn("Failed to handle queued decorated events in sink, retrying")
+	})
+	if err == nil {
+		return
+	}
+
+	c.log.
+		WithError(err).
+		WithField("sink", q.sink.Name()).
+		WithField("events", len(events)).
+		Error("Gave up on queued decorated events at shutdown, dropping them")
+
+	network := string(c.beacon.Metadata().Network.Name)
+
+	for _, event := range events {
+		c.metrics.AddSinkDroppedEvent(1, q.sink.Type(), event, network)
+	}
+}
+
+// enqueueForSink queues the events for the sink's workers. When the queue is full the deriver waits for room,
+// the same as it would for a busy sink. Derivers that failed to stop in time may still emit after the queue has been
+// closed; they get errAsyncSinkStopped instead.
+func (c *Cannon) enqueueForSink(ctx context.Context, q *asyncSink, events []*xatu.DecoratedEvent) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return errAsyncSinkStopped
+	}
+
+	select {
+	case q.queue <- events:
+		return nil
+	default:
+	}
+
+	c.metrics.AddSinkBusy(q.sink.Name(), string(c.beacon.Metadata().Network.Name))
+
+	c.log.
+		WithField("sink", q.sink.Name()).
+		Debug("Async queue for sink is full, pausing until it drains")
+
+	select {
+	case q.queue <- events:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-q.stopping:
+		return errAsyncSinkStopped
+	}
+}
+
+// close stops the queue from accepting events and closes it so the workers exit once it is drained.
+func (q *asyncSink) close() {
+	close(q.stopping)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+
+	close(q.queue)
+}
+
+// stopAsyncSinks closes the queues and waits for the workers to hand everything queued to the sinks. Delivery is
+// abandoned if ctx is done first. Derivers that are still running afterwards have their events rejected.
+func (c *Cannon) stopAsyncSinks(ctx context.Context) {
+	if c.asyncSinks == nil {
+		return
+	}
+
+	defer c.asyncSinksCancel()
+
+	for _, q := range c.asyncSinks {
+		q.close()
+	}
+
+	for _, q := range c.asyncSinks {
+		done := make(chan struct{})
+
+		go func(q *asyncSink) {
+			q.wg.Wait()
+			close(done)
+		}(q)
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			c.log.
+				WithField("sink", q.sink.Name()).
+				WithField("queued", len(q.queue)).
+				Warn("Timed out draining async queue for sink")
+
+			return
+		}
+	}
+}
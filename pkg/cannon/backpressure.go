@@ -0,0 +1,49 @@
+package cannon
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/output"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+)
+
+const (
+	sinkBusyInitialInterval = 100 * time.Millisecond
+	sinkBusyMaxInterval     = 5 * time.Second
+)
+
+// handToSink hands the events to the sink, waiting for as long as the sink reports it is busy. The deriver calling
+// us is blocked in the meantime, which pauses its iterator until the sink has drained.
+// Only batched sinks report ErrSinkBusy. The cannon creates its sinks with ShippingMethodSync, so an unbatched sink
+// never queues events: it blocks us until they are shipped, which holds the deriver back the same way.
+func (c *Cannon) handToSink(ctx context.Context, sink output.Sink, events []*xatu.DecoratedEvent) error {
+	interval := sinkBusyInitialInterval
+
+	for {
+		err := sink.HandleNewDecoratedEvents(ctx, events)
+		if !errors.Is(err, output.ErrSinkBusy) {
+			return err
+		}
+
+		c.metrics.AddSinkBusy(sink.Name(), string(c.beacon.Metadata().Network.Name))
+
+		c.log.
+			WithError(err).
+			WithField("sink", sink.Name()).
+			WithField("retry_in", interval).
+			Debug("Sink is busy, pausing until it drains")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > sinkBusyMaxInterval {
+			interval = sinkBusyMaxInterval
+		}
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -16,45 +19,86 @@ import (
 
 	"github.com/beevik/ntp"
 	aBlockprint "github.com/ethpandaops/xatu/pkg/cannon/blockprint"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
 	"github.com/ethpandaops/xatu/pkg/cannon/coordinator"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/deriver"
 	v1 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v1"
 	v2 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v2"
 	"github.com/ethpandaops/xatu/pkg/cannon/deriver/blockprint"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/cannon/pause"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	"github.com/ethpandaops/xatu/pkg/output"
 	"github.com/ethpandaops/xatu/pkg/proto/xatu"
 	"github.com/go-co-op/gocron"
 	"github.com/google/uuid"
 	perrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 )
 
+// deriverShutdownTimeout is the maximum amount of time to wait for derivers to finish their in-flight work on shutdown.
+const deriverShutdownTimeout = time.Minute
+
 type Cannon struct {
 	Config *Config
 
 	sinks []output.Sink
+	// sinkFilters are keyed by sink name and decide which events are routed to each sink
+	sinkFilters map[string]xatu.EventFilter
+	// deadLetterSink is nil unless a dead-letter output is configured
+	deadLetterSink output.Sink
+	// asyncSinks line up with sinks by index and are nil unless asyncOutputs is enabled
+	asyncSinks       []*asyncSink
+	asyncSinksCancel context.CancelFunc
+	// rateLimiter is nil when maxEventsPerSecond is unlimited
+	rateLimiter *eventRateLimiter
 
 	beacon *ethereum.BeaconNode
 
-	clockDrift time.Duration
+	clockDriftMu       sync.RWMutex
+	clockDrift         time.Duration
+	clockDriftServer   string
+	clockDriftSyncedAt time.Time
+
+	// clientMeta is replaced, never mutated, when refreshed so derivers can clone it without holding the lock
+	clientMetaMu sync.RWMutex
+	clientMeta   *xatu.ClientMeta
 
 	log logrus.FieldLogger
 
 	id uuid.UUID
 
 	metrics *Metrics
+	// registerer registers every cannon metric, with the configured metricsLabels applied
+	registerer prometheus.Registerer
 
 	scheduler *gocron.Scheduler
 
-	eventDerivers []deriver.EventDeriver
+	// eventDeriversMu guards eventDerivers and deriverDeps, which change when the config is reloaded
+	eventDeriversMu sync.Mutex
+	eventDerivers   []deriver.EventDeriver
+	deriverDeps     *deriverDeps
+
+	// configLoader re-reads the config file on SIGHUP. Reloading is disabled when nil.
+	configLoader func() (*Config, error)
 
 	coordinatorClient *coordinator.Client
 
+	// pauses holds derivers paused through the control endpoint
+	pauses *pause.Controller
+
+	// slotDeadlines enforces the derivers' slot deadlines and keeps the slots skipped because of them
+	slotDeadlines *deadline.Tracker
+
 	shutdownFuncs []func(ctx context.Context) error
+
+	// ready is set once the beacon node is ready and the event derivers have been started
+	ready atomic.Bool
 }
 
 func New(ctx context.Context, log logrus.FieldLogger, config *Config) (*Cannon, error) {
@@ -66,34 +110,90 @@ func New(ctx context.Context, log logrus.FieldLogger, config *Config) (*Cannon,
 		return nil, err
 	}
 
+	registerer := prometheus.DefaultRegisterer
+	if len(config.MetricsLabels) > 0 {
+		registerer = prometheus.WrapRegistererWith(config.MetricsLabels, registerer)
+	}
+
 	sinks, err := config.CreateSinks(log)
 	if err != nil {
 		return nil, err
 	}
 
-	beacon, err := ethereum.NewBeaconNode(ctx, config.Name, &config.Ethereum, log)
+	deadLetterSink, err := config.CreateDeadLetterSink(log)
+	if err != nil {
+		return nil, perrors.Wrap(err, "failed to create dead-letter sink")
+	}
+
+	sinkFilters := make(map[string]xatu.EventFilter, len(config.Outputs))
+
+	for _, out := range config.Outputs {
+		filterConfig := out.FilterConfig
+
+		filter, err := xatu.NewEventFilter(&filterConfig)
+		if err != nil {
+			return nil, perrors.Wrapf(err, "failed to create event filter for sink %s", out.Name)
+		}
+
+		sinkFilters[out.Name] = filter
+	}
+
+	config.Ethereum.BeaconNodeHeaders = withUserAgent(config.Ethereum.BeaconNodeHeaders, config.GetUserAgent())
+
+	beacon, err := ethereum.NewBeaconNode(ctx, config.Name, config.MetricsNamespace, registerer, &config.Ethereum, log)
 	if err != nil {
 		return nil, err
 	}
 
-	coordinatorClient, err := coordinator.New(&config.Coordinator, log)
+	timezone, err := time.LoadLocation(config.Timezone)
+	if err != nil {
+		return nil, perrors.Wrap(err, "failed to load timezone")
+	}
+
+	coordinatorClient, err := coordinator.New(&config.Coordinator, config.MetricsNamespace, registerer, log)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Cannon{
+	if config.DryRun {
+		log.Warn("Dry run enabled: events will not be sent to outputs and locations will not be persisted to the coordinator")
+
+		coordinatorClient.EnableDryRun()
+	}
+
+	slotDeadlines, err := deadline.NewTracker(config.MetricsNamespace, registerer, log, config.Derivers.SkippedSlotsPath)
+	if err != nil {
+		return nil, perrors.Wrap(err, "failed to create slot deadline tracker")
+	}
+
+	var rateLimiter *eventRateLimiter
+	if config.MaxEventsPerSecond > 0 {
+		rateLimiter = newEventRateLimiter(config.MaxEventsPerSecond)
+	}
+
+	c := &Cannon{
 		Config:            config,
 		sinks:             sinks,
+		sinkFilters:       sinkFilters,
+		deadLetterSink:    deadLetterSink,
+		rateLimiter:       rateLimiter,
 		beacon:            beacon,
 		clockDrift:        time.Duration(0),
 		log:               log,
 		id:                uuid.New(),
-		metrics:           NewMetrics("xatu_cannon"),
-		scheduler:         gocron.NewScheduler(time.Local),
+		metrics:           NewMetrics(config.MetricsNamespace, registerer),
+		registerer:        registerer,
+		scheduler:         gocron.NewScheduler(timezone),
 		eventDerivers:     nil, // Derivers are created once the beacon node is ready
 		coordinatorClient: coordinatorClient,
+		pauses:            pause.NewController(),
+		slotDeadlines:     slotDeadlines,
 		shutdownFuncs:     []func(ctx context.Context) error{},
-	}, nil
+	}
+
+	c.deadLetterRejectedBatches()
+
+	return c, nil
 }
 
 func (c *Cannon) Start(ctx context.Context) error {
@@ -151,19 +251,44 @@ func (c *Cannon) Start(ctx context.Context) error {
 		}
 	}
 
+	if c.deadLetterSink != nil {
+		if err := c.deadLetterSink.Start(ctx); err != nil {
+			return perrors.Wrap(err, "failed to start dead-letter sink")
+		}
+	}
+
+	if c.Config.AsyncOutputs.Enabled {
+		c.startAsyncSinks()
+	}
+
 	if c.Config.Ethereum.OverrideNetworkName != "" {
 		c.log.WithField("network", c.Config.Ethereum.OverrideNetworkName).Info("Overriding network name")
 	}
 
+	if c.Config.Ethereum.OverrideNetworkID != nil {
+		c.log.WithField("network_id", *c.Config.Ethereum.OverrideNetworkID).Info("Overriding network ID")
+	}
+
 	if err := c.beacon.Start(ctx); err != nil {
 		return err
 	}
 
-	cancel := make(chan os.Signal, 1)
-	signal.Notify(cancel, syscall.SIGTERM, syscall.SIGINT)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
+
+	for sig := range signals {
+		if sig == syscall.SIGHUP {
+			if err := c.reloadDerivers(ctx); err != nil {
+				c.log.WithError(err).Error("Failed to reload deriver config")
+			}
+
+			continue
+		}
+
+		c.log.Printf("Caught signal: %v", sig)
 
-	sig := <-cancel
-	c.log.Printf("Caught signal: %v", sig)
+		break
+	}
 
 	if err := c.Shutdown(ctx); err != nil {
 		return err
@@ -175,33 +300,65 @@ func (c *Cannon) Start(ctx context.Context) error {
 func (c *Cannon) Shutdown(ctx context.Context) error {
 	c.log.Printf("Shutting down")
 
+	// Stop the derivers first so any in-flight locations are processed and persisted before we flush the sinks.
+	deriverCtx, cancel := context.WithTimeout(ctx, deriverShutdownTimeout)
+	defer cancel()
+
+	c.eventDeriversMu.Lock()
+	defer c.eventDeriversMu.Unlock()
+
+	for _, deriver := range c.eventDerivers {
+		if err := deriver.Stop(deriverCtx); err != nil {
+			c.log.WithError(err).WithField("deriver", deriver.Name()).Warn("Failed to gracefully stop deriver")
+		}
+	}
+
+	c.stopAsyncSinks(deriverCtx)
+
 	for _, sink := range c.sinks {
 		if err := sink.Stop(ctx); err != nil {
 			return err
 		}
 	}
 
-	for _, fun := range c.shutdownFuncs {
-		if err := fun(ctx); err != nil {
+	if c.deadLetterSink != nil {
+		if err := c.deadLetterSink.Stop(ctx); err != nil {
 			return err
 		}
 	}
 
-	c.scheduler.Stop()
-
-	for _, deriver := range c.eventDerivers {
-		if err := deriver.Stop(ctx); err != nil {
+	for _, fun := range c.shutdownFuncs {
+		if err := fun(ctx); err != nil {
 			return err
 		}
 	}
 
+	c.scheduler.Stop()
+
 	return nil
 }
 
 func (c *Cannon) ServeMetrics(ctx context.Context) error {
+	if c.Config.MetricsAddr == "" {
+		c.log.Info("Metrics address is empty, not serving metrics or health probes")
+
+		return nil
+	}
+
 	go func() {
 		sm := http.NewServeMux()
 		sm.Handle("/metrics", promhttp.Handler())
+		sm.HandleFunc("/healthz", c.handleHealthz)
+		sm.HandleFunc("/readyz", c.handleReadyz)
+		sm.HandleFunc("/drift", c.handleDrift)
+		sm.HandleFunc("/skipped-slots", c.handleSkippedSlots)
+		sm.HandleFunc("/events", c.handleEventCounts)
+		sm.HandleFunc("/coverage", c.handleCoverage)
+
+		if c.Config.Control.Enabled {
+			sm.HandleFunc("/derivers/pause", c.handlePauseDerivers)
+			sm.HandleFunc("/derivers/resume", c.handleResumeDerivers)
+		}
 
 		server := &http.Server{
 			Addr:              c.Config.MetricsAddr,
@@ -249,6 +406,15 @@ func (c *Cannon) createNewClientMeta(ctx context.Context) (*xatu.ClientMeta, err
 		if c.Config.Ethereum.OverrideNetworkName != "" {
 			networkMeta.Name = c.Config.Ethereum.OverrideNetworkName
 		}
+
+		if c.Config.Ethereum.OverrideNetworkID != nil {
+			networkMeta.Id = *c.Config.Ethereum.OverrideNetworkID
+		}
+	}
+
+	labels, err := expandLabels(c.Config.Labels)
+	if err != nil {
+		return nil, err
 	}
 
 	return &xatu.ClientMeta{
@@ -257,7 +423,7 @@ func (c *Cannon) createNewClientMeta(ctx context.Context) (*xatu.ClientMeta, err
 		Id:             c.id.String(),
 		Implementation: xatu.Implementation,
 		Os:             runtime.GOOS,
-		ClockDrift:     uint64(c.clockDrift.Milliseconds()),
+		ClockDrift:     uint64(c.getClockDrift().Milliseconds()),
 		Ethereum: &xatu.ClientMeta_Ethereum{
 			Network:   networkMeta,
 			Execution: &xatu.ClientMeta_Ethereum_Execution{},
@@ -266,14 +432,71 @@ func (c *Cannon) createNewClientMeta(ctx context.Context) (*xatu.ClientMeta, err
 				Version:        c.beacon.Metadata().NodeVersion(ctx),
 			},
 		},
-		Labels: c.Config.Labels,
+		Labels: labels,
 	}, nil
 }
 
+// refreshClientMeta rebuilds the client meta attached to derived events so changes such as a beacon node upgrade
+// or a new clock drift are picked up while running.
+func (c *Cannon) refreshClientMeta(ctx context.Context) error {
+	meta, err := c.createNewClientMeta(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.clientMetaMu.Lock()
+	defer c.clientMetaMu.Unlock()
+
+	if previous := c.clientMeta; previous != nil {
+		before := previous.GetEthereum().GetConsensus()
+		after := meta.GetEthereum().GetConsensus()
+
+		if before.GetVersion() != after.GetVersion() || before.GetImplementation() != after.GetImplementation() {
+			c.log.
+				WithField("previous_version", before.GetVersion()).
+				WithField("version", after.GetVersion()).
+				WithField("implementation", after.GetImplementation()).
+				Info("Beacon node version changed")
+		}
+	}
+
+	c.clientMeta = meta
+
+	return nil
+}
+
+func (c *Cannon) getClientMeta() *xatu.ClientMeta {
+	c.clientMetaMu.RLock()
+	defer c.clientMetaMu.RUnlock()
+
+	return c.clientMeta
+}
+
 func (c *Cannon) startCrons(ctx context.Context) error {
-	if _, err := c.scheduler.Every("5m").Do(func() {
-		if err := c.syncClockDrift(ctx); err != nil {
-			c.log.WithError(err).Error("Failed to sync clock drift")
+	if c.Config.NTPDisabled {
+		c.log.Info("NTP is disabled, clock drift will not be measured and the system clock is used as is")
+	} else {
+		if _, err := c.scheduler.Every(c.Config.NTPSyncInterval).Do(func() {
+			if err := c.syncClockDrift(ctx); err != nil {
+				c.log.WithError(err).Error("Failed to sync clock drift")
+			}
+		}); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.scheduler.Every(c.Config.ClientMetaRefreshInterval).Do(func() {
+		c.clientMetaMu.RLock()
+		initialized := c.clientMeta != nil
+		c.clientMetaMu.RUnlock()
+
+		// The client meta is created once the beacon node is ready.
+		if !initialized {
+			return
+		}
+
+		if err := c.refreshClientMeta(ctx); err != nil {
+			c.log.WithError(err).Error("Failed to refresh client meta")
 		}
 	}); err != nil {
 		return err
@@ -285,254 +508,729 @@ func (c *Cannon) startCrons(ctx context.Context) error {
 }
 
 func (c *Cannon) syncClockDrift(ctx context.Context) error {
-	response, err := ntp.Query(c.Config.NTPServer)
+	for _, server := range c.Config.NTPServer {
+		response, err := ntp.Query(server)
+		if err != nil {
+			c.log.WithError(err).WithField("server", server).Warn("Failed to query NTP server")
+			c.metrics.AddNTPQuery(server, false)
+
+			continue
+		}
+
+		if err := response.Validate(); err != nil {
+			c.log.WithError(err).WithField("server", server).Warn("Invalid response from NTP server")
+			c.metrics.AddNTPQuery(server, false)
+
+			continue
+		}
+
+		c.metrics.AddNTPQuery(server, true)
+		c.metrics.SetNTPClockOffset(response.ClockOffset)
+
+		c.setClockDrift(response.ClockOffset, server)
+		c.log.WithField("drift", response.ClockOffset).WithField("server", server).Info("Updated clock drift")
+
+		return nil
+	}
+
+	// Keep the last known drift rather than resetting it.
+	return fmt.Errorf("all %d NTP servers failed, keeping last known clock drift of %s", len(c.Config.NTPServer), c.getClockDrift())
+}
+
+// deriverLagSlots calculates how many slots the deriver's location is behind the finalized checkpoint.
+func (c *Cannon) deriverLagSlots(cannonType xatu.CannonType, location uint64) (uint64, error) {
+	finalizedSlot, err := c.finalizedSlot()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	err = response.Validate()
+	locationSlot, err := c.locationSlot(cannonType, location)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	c.clockDrift = response.ClockOffset
-	c.log.WithField("drift", c.clockDrift).Info("Updated clock drift")
+	if locationSlot >= finalizedSlot {
+		return 0, nil
+	}
 
-	return err
+	return finalizedSlot - locationSlot, nil
 }
 
-func (c *Cannon) handleNewDecoratedEvents(ctx context.Context, events []*xatu.DecoratedEvent) error {
-	for _, sink := range c.sinks {
-		if err := sink.HandleNewDecoratedEvents(ctx, events); err != nil {
+// finalizedSlot returns the first slot of the finalized epoch.
+func (c *Cannon) finalizedSlot() (uint64, error) {
+	sp, err := c.beacon.Node().Spec()
+	if err != nil {
+		return 0, perrors.Wrap(err, "failed to obtain spec")
+	}
+
+	finality, err := c.beacon.Node().Finality()
+	if err != nil {
+		return 0, perrors.Wrap(err, "failed to obtain finality")
+	}
+
+	if finality == nil || finality.Finalized == nil {
+		return 0, errors.New("finality is not available")
+	}
+
+	return uint64(finality.Finalized.Epoch) * uint64(sp.SlotsPerEpoch), nil
+}
+
+// locationSlot converts a deriver location to a slot. Blockprint tracks its location in slots while all
+// other derivers track epochs.
+func (c *Cannon) locationSlot(cannonType xatu.CannonType, location uint64) (uint64, error) {
+	if cannonType == xatu.CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION {
+		return location, nil
+	}
+
+	sp, err := c.beacon.Node().Spec()
+	if err != nil {
+		return 0, perrors.Wrap(err, "failed to obtain spec")
+	}
+
+	return location * uint64(sp.SlotsPerEpoch), nil
+}
+
+func (c *Cannon) handleNewDecoratedEvents(ctx context.Context, cannonType xatu.CannonType, events []*xatu.DecoratedEvent) error {
+	network := string(c.beacon.Metadata().Network.Name)
+
+	if c.Config.DryRun {
+		for _, event := range events {
+			c.metrics.AddDecoratedEvent(1, event, network)
+		}
+
+		c.metrics.AddDeriverEvents(len(events), cannonType, network)
+
+		return nil
+	}
+
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx, len(events)); err != nil {
+			return perrors.Wrap(err, "failed waiting for event rate limit")
+		}
+	}
+
+	routed := make([]bool, len(events))
+
+	for i, sink := range c.sinks {
+		filtered, err := c.filterEventsForSink(sink, events, routed)
+		if err != nil {
+			return perrors.Wrapf(err, "failed to filter events for sink %s", sink.Name())
+		}
+
+		if len(filtered) == 0 {
+			continue
+		}
+
+		if c.asyncSinks != nil {
+			if err := c.enqueueForSink(ctx, c.asyncSinks[i], filtered); err != nil {
+				return perrors.Wrapf(err, "failed to queue decorated events for sink %s", sink.Name())
+			}
+
+			continue
+		}
+
+		if err := c.sendToSink(ctx, sink, filtered); err != nil {
 			return perrors.Wrapf(err, "failed to handle new decorated events in sink %s", sink.Name())
 		}
 	}
 
-	for _, event := range events {
-		c.metrics.AddDecoratedEvent(1, event, string(c.beacon.Metadata().Network.Name))
+	for i, event := range events {
+		c.metrics.AddDecoratedEvent(1, event, network)
+
+		if !routed[i] {
+			c.metrics.AddDroppedDecoratedEvent(1, event, network)
+		}
 	}
 
+	c.metrics.AddDeriverEvents(len(events), cannonType, network)
+
 	return nil
 }
 
+// filterEventsForSink returns the events that the sink's filter accepts, marking each accepted event as routed.
+func (c *Cannon) filterEventsForSink(sink output.Sink, events []*xatu.DecoratedEvent, routed []bool) ([]*xatu.DecoratedEvent, error) {
+	filter, ok := c.sinkFilters[sink.Name()]
+	if !ok {
+		for i := range routed {
+			routed[i] = true
+		}
+
+		return events, nil
+	}
+
+	filtered := make([]*xatu.DecoratedEvent, 0, len(events))
+
+	for i, event := range events {
+		shouldBeDropped, err := filter.ShouldBeDropped(event)
+		if err != nil {
+			return nil, err
+		}
+
+		if shouldBeDropped {
+			continue
+		}
+
+		routed[i] = true
+
+		filtered = append(filtered, event)
+	}
+
+	return filtered, nil
+}
+
 func (c *Cannon) startBeaconBlockProcessor(ctx context.Context) error {
 	c.beacon.OnReady(ctx, func(ctx context.Context) error {
-		c.log.Info("Internal beacon node is ready, firing up event derivers")
-
 		networkName := string(c.beacon.Metadata().Network.Name)
+
+		if expected := c.Config.Ethereum.ExpectedNetworkName; expected != "" && !strings.EqualFold(expected, networkName) {
+			c.log.
+				WithField("expected_network", expected).
+				WithField("beacon_network", networkName).
+				Fatal("Beacon node is on a different network than ethereum.expectedNetworkName, refusing to start event derivers")
+		}
+
+		c.log.Info("Internal beacon node is ready, firing up event derivers")
 		networkID := fmt.Sprintf("%d", c.beacon.Metadata().Network.ID)
 
-		wallclock := c.beacon.Metadata().Wallclock()
+		if err := c.refreshClientMeta(ctx); err != nil {
+			return err
+		}
 
-		clientMeta, err := c.createNewClientMeta(ctx)
-		if err != nil {
+		c.eventDeriversMu.Lock()
+		defer c.eventDeriversMu.Unlock()
+
+		c.deriverDeps = &deriverDeps{
+			networkName:                 networkName,
+			networkID:                   networkID,
+			wallclock:                   c.beacon.Metadata().Wallclock(),
+			nodeVersion:                 c.beacon.Metadata().NodeVersion(ctx),
+			checkpointIteratorMetrics:   iterator.NewCheckpointMetrics(c.Config.MetricsNamespace, c.registerer),
+			blockprintIteratorMetrics:   iterator.NewBlockprintMetrics(c.Config.MetricsNamespace, c.registerer),
+			circuitBreakerMetrics:       circuitbreaker.NewMetrics(c.Config.MetricsNamespace, c.registerer),
+			dedupMetrics:                dedup.NewMetrics(c.Config.MetricsNamespace, c.registerer),
+			executionTransactionMetrics: v2.NewMetrics(c.Config.MetricsNamespace, c.registerer),
+		}
+
+		eventDerivers := c.createEventDerivers(&c.Config.Derivers)
+
+		if err := deriver.CheckUniqueCannonTypes(eventDerivers); err != nil {
 			return err
 		}
 
-		checkpointIteratorMetrics := iterator.NewCheckpointMetrics("xatu_cannon")
+		if len(eventDerivers) == 0 {
+			c.log.Warn("No event derivers are enabled")
+		}
 
-		blockprintIteratorMetrics := iterator.NewBlockprintMetrics("xatu_cannon")
+		c.logDeriverSummary(eventDerivers, networkName, networkID)
 
-		finalizedCheckpoint := "finalized"
+		c.eventDerivers = eventDerivers
 
-		blockprintClient := aBlockprint.NewClient(
-			c.Config.Derivers.BlockClassificationConfig.Endpoint,
-			c.Config.Derivers.BlockClassificationConfig.Headers,
-		)
+		for _, d := range c.eventDerivers {
+			if err := c.startEventDeriver(ctx, &c.Config.Derivers, d); err != nil {
+				return err
+			}
+		}
+
+		c.ready.Store(true)
+
+		return nil
+	})
+
+	return nil
+}
+
+// newCircuitBreaker returns the circuit breaker for a single deriver. Each deriver gets its own so one failing
+// endpoint doesn't pause derivers that are still healthy.
+func (c *Cannon) newCircuitBreaker(cfg *deriver.Config, cannonType xatu.CannonType) *circuitbreaker.Breaker {
+	return circuitbreaker.New(cannonType.String(), &cfg.CircuitBreaker, c.pauses.Gate(cannonType.String()), c.deriverDeps.circuitBreakerMetrics, c.log)
+}
+
+// createEventDerivers builds, but does not start, a deriver for every type enabled in cfg.
+// The beacon node must be ready so deriverDeps is populated.
+func (c *Cannon) createEventDerivers(cfg *deriver.Config) []deriver.EventDeriver {
+	deps := c.deriverDeps
+
+	finalizedCheckpoint := "finalized"
+
+	blockprintClient := aBlockprint.NewClient(
+		cfg.BlockClassificationConfig.Endpoint,
+		withUserAgent(cfg.BlockClassificationConfig.Headers, c.Config.GetUserAgent()),
+	)
+
+	eventDerivers := []deriver.EventDeriver{}
+
+	if cfg.AttesterSlashingConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewAttesterSlashingDeriver(
+			c.log,
+			&cfg.AttesterSlashingConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTER_SLASHING,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
+				c.beacon,
+				finalizedCheckpoint,
+				&cfg.Iterator,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTER_SLASHING),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.ProposerSlashingConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewProposerSlashingDeriver(
+			c.log,
+			&cfg.ProposerSlashingConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_PROPOSER_SLASHING,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
+				c.beacon,
+				finalizedCheckpoint,
+				&cfg.Iterator,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_PROPOSER_SLASHING),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
 
-		eventDerivers := []deriver.EventDeriver{
-			v2.NewAttesterSlashingDeriver(
+	if cfg.VoluntaryExitConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewVoluntaryExitDeriver(
+			c.log,
+			&cfg.VoluntaryExitConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.AttesterSlashingConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTER_SLASHING,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v2.NewProposerSlashingDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.DepositConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewDepositDeriver(
+			c.log,
+			&cfg.DepositConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.ProposerSlashingConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_PROPOSER_SLASHING,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v2.NewVoluntaryExitDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.BLSToExecutionConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewBLSToExecutionChangeDeriver(
+			c.log,
+			&cfg.BLSToExecutionConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.VoluntaryExitConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_BLS_TO_EXECUTION_CHANGE,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v2.NewDepositDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_BLS_TO_EXECUTION_CHANGE),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.ExecutionTransactionConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewExecutionTransactionDeriver(
+			c.log,
+			&cfg.ExecutionTransactionConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.DepositConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_TRANSACTION,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v2.NewBLSToExecutionChangeDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_TRANSACTION),
+			c.slotDeadlines,
+			c.getClientMeta,
+			deps.executionTransactionMetrics,
+		))
+	}
+
+	if cfg.WithdrawalConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewWithdrawalDeriver(
+			c.log,
+			&cfg.WithdrawalConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.BLSToExecutionConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_BLS_TO_EXECUTION_CHANGE,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_WITHDRAWAL,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v2.NewExecutionTransactionDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_WITHDRAWAL),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.BeaconBlockConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewBeaconBlockDeriver(
+			c.log,
+			&cfg.BeaconBlockConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.ExecutionTransactionConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_TRANSACTION,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v2.NewWithdrawalDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.BlockClassificationConfig.Enabled {
+		eventDerivers = append(eventDerivers, blockprint.NewBlockClassificationDeriver(
+			c.log,
+			&cfg.BlockClassificationConfig,
+			iterator.NewBlockprintIterator(
 				c.log,
-				&c.Config.Derivers.WithdrawalConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_WITHDRAWAL,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION,
+				c.coordinatorClient,
+				&deps.blockprintIteratorMetrics,
+				blockprintClient,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION),
+			c.getClientMeta,
+			blockprintClient,
+		))
+	}
+
+	if cfg.BeaconBlobSidecarConfig.Enabled {
+		eventDerivers = append(eventDerivers, v1.NewBeaconBlobDeriver(
+			c.log,
+			&cfg.BeaconBlobSidecarConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v2.NewBeaconBlockDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.SyncAggregateConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewSyncAggregateDeriver(
+			c.log,
+			&cfg.SyncAggregateConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.BeaconBlockConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			blockprint.NewBlockClassificationDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.BlockSummaryConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewBlockSummaryDeriver(
+			c.log,
+			&cfg.BlockSummaryConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.BlockClassificationConfig,
-				iterator.NewBlockprintIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION,
-					c.coordinatorClient,
-					&blockprintIteratorMetrics,
-					blockprintClient,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
-				blockprintClient,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-			v1.NewBeaconBlobDeriver(
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.BeaconBlockRewardConfig.Enabled {
+		eventDerivers = append(eventDerivers, v1.NewBeaconBlockRewardDeriver(
+			c.log,
+			&cfg.BeaconBlockRewardConfig,
+			iterator.NewCheckpointIterator(
 				c.log,
-				&c.Config.Derivers.BeaconBlobSidecarConfig,
-				iterator.NewCheckpointIterator(
-					c.log,
-					networkName,
-					networkID,
-					xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR,
-					c.coordinatorClient,
-					wallclock,
-					&checkpointIteratorMetrics,
-					c.beacon,
-					finalizedCheckpoint,
-				),
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
 				c.beacon,
-				clientMeta,
+				finalizedCheckpoint,
+				&cfg.Iterator,
 			),
-		}
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
 
-		c.eventDerivers = eventDerivers
+	if cfg.AttestationConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewAttestationDeriver(
+			c.log,
+			&cfg.AttestationConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
+				c.beacon,
+				finalizedCheckpoint,
+				&cfg.Iterator,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
 
-		for _, deriver := range c.eventDerivers {
-			d := deriver
+	if cfg.ExecutionPayloadConfig.Enabled {
+		eventDerivers = append(eventDerivers, v2.NewExecutionPayloadDeriver(
+			c.log,
+			&cfg.ExecutionPayloadConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
+				c.beacon,
+				finalizedCheckpoint,
+				&cfg.Iterator,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD),
+			c.slotDeadlines,
+			c.getClientMeta,
+		))
+	}
 
-			d.OnEventsDerived(ctx, func(ctx context.Context, events []*xatu.DecoratedEvent) error {
-				return c.handleNewDecoratedEvents(ctx, events)
-			})
+	if cfg.ValidatorActivityConfig.Enabled {
+		eventDerivers = append(eventDerivers, v1.NewValidatorActivityDeriver(
+			c.log,
+			&cfg.ValidatorActivityConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
+				c.beacon,
+				finalizedCheckpoint,
+				&cfg.Iterator,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY),
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.LightClientUpdateConfig.Enabled {
+		eventDerivers = append(eventDerivers, v1.NewLightClientUpdateDeriver(
+			c.log,
+			&cfg.LightClientUpdateConfig,
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V1_BEACON_LIGHT_CLIENT_UPDATE),
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.ProposerDutyConfig.Enabled {
+		eventDerivers = append(eventDerivers, v1.NewProposerDutyDeriver(
+			c.log,
+			&cfg.ProposerDutyConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
+				c.beacon,
+				finalizedCheckpoint,
+				&cfg.Iterator,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY),
+			c.getClientMeta,
+		))
+	}
+
+	if cfg.BeaconCommitteeConfig.Enabled {
+		eventDerivers = append(eventDerivers, v1.NewBeaconCommitteeDeriver(
+			c.log,
+			&cfg.BeaconCommitteeConfig,
+			iterator.NewCheckpointIterator(
+				c.log,
+				deps.networkName,
+				deps.networkID,
+				xatu.CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE,
+				c.coordinatorClient,
+				deps.wallclock,
+				&deps.checkpointIteratorMetrics,
+				c.beacon,
+				finalizedCheckpoint,
+				&cfg.Iterator,
+			),
+			c.beacon,
+			c.newCircuitBreaker(cfg, xatu.CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE),
+			c.getClientMeta,
+		))
+	}
+
+	return c.withoutUnsupportedDerivers(eventDerivers)
+}
+
+// withoutUnsupportedDerivers drops derivers whose minimum beacon node version the connected node doesn't meet,
+// rather than letting them run against endpoints the node doesn't serve.
+func (c *Cannon) withoutUnsupportedDerivers(eventDerivers []deriver.EventDeriver) []deriver.EventDeriver {
+	supported := make([]deriver.EventDeriver, 0, len(eventDerivers))
 
+	for _, d := range eventDerivers {
+		if err := deriver.CheckNodeVersion(d, c.deriverDeps.nodeVersion); err != nil {
 			c.log.
-				WithField("deriver", deriver.Name()).
-				WithField("type", deriver.CannonType()).
-				Info("Starting cannon event deriver")
+				WithError(err).
+				WithField("deriver", d.Name()).
+				WithField("node_version", c.deriverDeps.nodeVersion).
+				Error("Beacon node is too old for this deriver, refusing to start it. Upgrade the beacon node or disable the deriver")
 
-			if err := deriver.Start(ctx); err != nil {
-				return err
-			}
+			continue
 		}
 
+		supported = append(supported, d)
+	}
+
+	return supported
+}
+
+// startEventDeriver starts a deriver built from cfg. Its events are deduplicated and split into batches here,
+// per cfg, before they are handed to the outputs.
+func (c *Cannon) startEventDeriver(ctx context.Context, cfg *deriver.Config, d deriver.EventDeriver) error {
+	networkName := c.deriverDeps.networkName
+
+	d.OnEventsDerived(ctx, cfg.WrapEventsCallback(d.CannonType(), c.deriverDeps.dedupMetrics, func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+		return c.handleNewDecoratedEvents(ctx, d.CannonType(), events)
+	}))
+
+	d.OnLocationUpdated(ctx, func(ctx context.Context, location uint64) error {
+		c.metrics.SetDeriverLocation(location, d.CannonType(), networkName)
+
+		lag, err := c.deriverLagSlots(d.CannonType(), location)
+		if err != nil {
+			c.log.WithError(err).WithField("deriver", d.Name()).Debug("Failed to calculate deriver lag")
+
+			return nil
+		}
+
+		c.metrics.SetDeriverLagSlots(lag, d.CannonType(), networkName)
+
 		return nil
 	})
 
-	return nil
+	c.log.
+		WithField("deriver", d.Name()).
+		WithField("type", d.CannonType()).
+		Info("Starting cannon event deriver")
+
+	return d.Start(ctx)
 }
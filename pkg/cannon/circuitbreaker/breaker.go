@@ -0,0 +1,176 @@
+package circuitbreaker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type state int
+
+const (
+	stateClosed state = iota
+	stateOpen
+	stateHalfOpen
+)
+
+// Gate holds a deriver before its next attempt, e.g. while an operator has paused it.
+type Gate interface {
+	Wait(ctx context.Context) error
+}
+
+// Breaker stops a deriver from hammering the beacon node while its requests keep failing. After the
+// configured number of consecutive failures it opens and holds off further attempts for the cooldown, then
+// half-opens and lets a single attempt through. A success closes it again, a failure re-opens it.
+// Only outcomes handed to Record count, so derivers record their beacon node requests and not, e.g., a sink
+// or the coordinator failing.
+type Breaker struct {
+	name    string
+	config  *Config
+	gate    Gate
+	metrics *Metrics
+	log     logrus.FieldLogger
+
+	mu       sync.Mutex
+	state    state
+	failures int
+	openedAt time.Time
+}
+
+func New(name string, config *Config, gate Gate, metrics *Metrics, log logrus.FieldLogger) *Breaker {
+	return &Breaker{
+		name:    name,
+		config:  config,
+		gate:    gate,
+		metrics: metrics,
+		log:     log.WithField("module", "cannon/circuitbreaker").WithField("deriver", name),
+	}
+}
+
+// Wrap returns an operation that waits while the gate is paused, and waits out the cooldown while the breaker
+// is open, before calling operation. Waiting is abandoned when ctx is done. The operation's result is not
+// recorded, see Record.
+func (b *Breaker) Wrap(ctx context.Context, operation func() error) func() error {
+	if b == nil {
+		return operation
+	}
+
+	return func() error {
+		if b.gate != nil {
+			if err := b.gate.Wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		if !b.config.Enabled {
+			return operation()
+		}
+
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+
+		return operation()
+	}
+}
+
+// Record counts the outcome of an attempt to fetch and process data from the beacon node. A nil err closes the
+// breaker, an error counts towards opening it.
+func (b *Breaker) Record(err error) {
+	if b == nil || !b.config.Enabled {
+		return
+	}
+
+	b.record(err)
+}
+
+// IsOpen reports whether the breaker is open or half-open. Derivers use it to skip logging failures the
+// breaker has already reported.
+func (b *Breaker) IsOpen() bool {
+	if b == nil {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state != stateClosed
+}
+
+func (b *Breaker) wait(ctx context.Context) error {
+	b.mu.Lock()
+
+	if b.state != stateOpen {
+		b.mu.Unlock()
+
+		return nil
+	}
+
+	remaining := b.config.Cooldown - time.Since(b.openedAt)
+
+	b.mu.Unlock()
+
+	if remaining > 0 {
+		timer := time.NewTimer(remaining)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	b.mu.Lock()
+	b.state = stateHalfOpen
+	b.mu.Unlock()
+
+	return nil
+}
+
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil {
+		if b.state != stateClosed {
+			b.log.Info("Circuit breaker closed, deriver recovered")
+
+			b.metrics.SetOpen(b.name, false)
+		}
+
+		b.state = stateClosed
+		b.failures = 0
+
+		return
+	}
+
+	b.failures++
+
+	switch b.state {
+	case stateHalfOpen:
+		b.state = stateOpen
+		b.openedAt = time.Now()
+
+		b.log.WithError(err).Debug("Circuit breaker test attempt failed, staying open")
+	case stateClosed:
+		if b.failures < b.config.FailureThreshold {
+			return
+		}
+
+		b.state = stateOpen
+		b.openedAt = time.Now()
+
+		b.metrics.SetOpen(b.name, true)
+		b.metrics.IncOpened(b.name)
+
+		b.log.
+			WithError(err).
+			WithField("consecutive_failures", b.failures).
+			WithField("cooldown", b.config.Cooldown).
+			Warn("Circuit breaker opened, pausing deriver. Further failures are not logged until it recovers")
+	case stateOpen:
+	}
+}
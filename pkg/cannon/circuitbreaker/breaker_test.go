@@ -0,0 +1,141 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errFailed = errors.New("failed")
+
+func newTestBreaker(config *Config) *Breaker {
+	return New("test", config, nil, NewMetrics("test", prometheus.NewRegistry()), logrus.New())
+}
+
+func TestBreakerRecord(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       Config
+		results      []error
+		expectedOpen bool
+	}{
+		{
+			name:         "below the threshold",
+			config:       Config{Enabled: true, FailureThreshold: 3, Cooldown: time.Hour},
+			results:      []error{errFailed, errFailed},
+			expectedOpen: false,
+		},
+		{
+			name:         "at the threshold",
+			config:       Config{Enabled: true, FailureThreshold: 3, Cooldown: time.Hour},
+			results:      []error{errFailed, errFailed, errFailed},
+			expectedOpen: true,
+		},
+		{
+			name:         "success resets the count",
+			config:       Config{Enabled: true, FailureThreshold: 3, Cooldown: time.Hour},
+			results:      []error{errFailed, errFailed, nil, errFailed, errFailed},
+			expectedOpen: false,
+		},
+		{
+			name:         "success closes an open breaker",
+			config:       Config{Enabled: true, FailureThreshold: 1, Cooldown: time.Hour},
+			results:      []error{errFailed, nil},
+			expectedOpen: false,
+		},
+		{
+			name:         "disabled",
+			config:       Config{Enabled: false, FailureThreshold: 1, Cooldown: time.Hour},
+			results:      []error{errFailed, errFailed},
+			expectedOpen: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			b := newTestBreaker(&config)
+
+			for _, err := range tt.results {
+				b.Record(err)
+			}
+
+			assert.Equal(t, tt.expectedOpen, b.IsOpen())
+		})
+	}
+}
+
+func TestBreakerWrapDoesNotRecord(t *testing.T) {
+	b := newTestBreaker(&Config{Enabled: true, FailureThreshold: 1, Cooldown: time.Hour})
+
+	operation := b.Wrap(context.Background(), func() error {
+		return errFailed
+	})
+
+	// Failures outside of Record, e.g. from a sink, never open the breaker.
+	for i := 0; i < 3; i++ {
+		require.ErrorIs(t, operation(), errFailed)
+	}
+
+	assert.False(t, b.IsOpen())
+}
+
+func TestBreakerWrapWaitsOutCooldown(t *testing.T) {
+	cooldown := 50 * time.Millisecond
+	b := newTestBreaker(&Config{Enabled: true, FailureThreshold: 1, Cooldown: cooldown})
+
+	b.Record(errFailed)
+	require.True(t, b.IsOpen())
+
+	started := time.Now()
+
+	require.NoError(t, b.Wrap(context.Background(), func() error {
+		return nil
+	})())
+
+	assert.GreaterOrEqual(t, time.Since(started), cooldown)
+
+	// The breaker is half-open until the test attempt's outcome is recorded.
+	assert.True(t, b.IsOpen())
+
+	b.Record(nil)
+
+	assert.False(t, b.IsOpen())
+}
+
+func TestBreakerWrapAbandonsCooldownWhenCancelled(t *testing.T) {
+	b := newTestBreaker(&Config{Enabled: true, FailureThreshold: 1, Cooldown: time.Hour})
+
+	b.Record(errFailed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+
+	err := b.Wrap(ctx, func() error {
+		called = true
+
+		return nil
+	})()
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.False(t, called)
+}
+
+func TestNilBreaker(t *testing.T) {
+	var b *Breaker
+
+	b.Record(errFailed)
+
+	assert.False(t, b.IsOpen())
+	assert.NoError(t, b.Wrap(context.Background(), func() error {
+		return nil
+	})())
+}
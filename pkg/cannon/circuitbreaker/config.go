@@ -0,0 +1,30 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	Enabled bool `yaml:"enabled" default:"true"`
+	// FailureThreshold is the number of consecutive failures that opens the breaker.
+	FailureThreshold int `yaml:"failureThreshold" default:"10"`
+	// Cooldown is how long the breaker stays open before a single attempt is let through to test recovery.
+	Cooldown time.Duration `yaml:"cooldown" default:"5m"`
+}
+
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.FailureThreshold < 1 {
+		return errors.New("failureThreshold must be at least 1")
+	}
+
+	if c.Cooldown <= 0 {
+		return errors.New("cooldown must be greater than 0")
+	}
+
+	return nil
+}
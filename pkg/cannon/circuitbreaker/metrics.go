@@ -0,0 +1,43 @@
+package circuitbreaker
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type Metrics struct {
+	open   *prometheus.GaugeVec
+	opened *prometheus.CounterVec
+}
+
+func NewMetrics(namespace string, registerer prometheus.Registerer) *Metrics {
+	namespace += "_circuit_breaker"
+
+	m := &Metrics{
+		open: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "open",
+			Help:      "1 if the deriver's circuit breaker is open or half-open, 0 if closed",
+		}, []string{"cannon_type"}),
+		opened: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "opened_total",
+			Help:      "The number of times the deriver's circuit breaker opened after consecutive failures",
+		}, []string{"cannon_type"}),
+	}
+
+	registerer.MustRegister(m.open)
+	registerer.MustRegister(m.opened)
+
+	return m
+}
+
+func (m *Metrics) SetOpen(cannonType string, open bool) {
+	value := 0.0
+	if open {
+		value = 1
+	}
+
+	m.open.WithLabelValues(cannonType).Set(value)
+}
+
+func (m *Metrics) IncOpened(cannonType string) {
+	m.opened.WithLabelValues(cannonType).Inc()
+}
@@ -3,6 +3,8 @@ package cannon
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ethpandaops/xatu/pkg/cannon/coordinator"
 	"github.com/ethpandaops/xatu/pkg/cannon/deriver"
@@ -10,6 +12,7 @@ import (
 	"github.com/ethpandaops/xatu/pkg/observability"
 	"github.com/ethpandaops/xatu/pkg/output"
 	"github.com/ethpandaops/xatu/pkg/processor"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,20 +21,53 @@ type Config struct {
 	MetricsAddr  string  `yaml:"metricsAddr" default:":9090"`
 	PProfAddr    *string `yaml:"pprofAddr"`
 
+	// LogFormat is the format logs are written in, text or json.
+	LogFormat observability.LogFormat `yaml:"logFormat" default:"text"`
+
+	// MetricsNamespace is the prometheus namespace the cannon metrics are registered under
+	MetricsNamespace string `yaml:"metricsNamespace" default:"xatu_cannon"`
+	// MetricsLabels are constant labels added to the cannon's own metrics
+	MetricsLabels map[string]string `yaml:"metricsLabels"`
+
 	// The name of the cannon
 	Name string `yaml:"name"`
 
+	// UserAgent is sent with requests to the beacon node, blockprint and HTTP outputs. Defaults to
+	// xatu-cannon/<version>.
+	UserAgent string `yaml:"userAgent"`
+
 	// Ethereum configuration
 	Ethereum ethereum.Config `yaml:"ethereum"`
 
 	// Outputs configuration
 	Outputs []output.Config `yaml:"outputs"`
 
-	// Labels configures the cannon with labels
+	// DeadLetter configures an optional sink that receives events the outputs failed to handle
+	DeadLetter *DeadLetterConfig `yaml:"deadLetter"`
+
+	// AsyncOutputs hands events to the outputs from dedicated goroutines so a slow output doesn't slow the derivers
+	AsyncOutputs AsyncOutputsConfig `yaml:"asyncOutputs"`
+
+	// Labels configures the cannon with labels. Values can reference environment variables as ${VAR} or
+	// ${VAR:-default}.
 	Labels map[string]string `yaml:"labels"`
 
-	// NTP Server to use for clock drift correction
-	NTPServer string `yaml:"ntpServer" default:"time.google.com"`
+	// NTP Servers to use for clock drift correction. Servers are tried in order until one responds.
+	NTPServer NTPServers `yaml:"ntpServer" default:"[\"time.google.com\"]"`
+
+	// NTPSyncInterval is how often the clock drift is recalculated against the NTP server
+	NTPSyncInterval time.Duration `yaml:"ntpSyncInterval" default:"5m"`
+
+	// NTPDisabled turns off clock drift measurement for environments without NTP access. Event times use the
+	// system clock as is.
+	NTPDisabled bool `yaml:"ntpDisabled" default:"false"`
+
+	// ClientMetaRefreshInterval is how often the client meta attached to events is rebuilt, e.g. to pick up a
+	// beacon node upgrade
+	ClientMetaRefreshInterval time.Duration `yaml:"clientMetaRefreshInterval" default:"5m"`
+
+	// Timezone is the IANA timezone the cron scheduler runs in
+	Timezone string `yaml:"timezone" default:"UTC"`
 
 	// Derivers configures the cannon with event derivers
 	Derivers deriver.Config `yaml:"derivers"`
@@ -41,23 +77,98 @@ type Config struct {
 
 	// Tracing configuration
 	Tracing observability.TracingConfig `yaml:"tracing"`
+
+	// MaxEventsPerSecond limits the rate events are sent to the outputs across all derivers. 0 is unlimited.
+	MaxEventsPerSecond int `yaml:"maxEventsPerSecond" default:"0"`
+
+	// Control serves authenticated endpoints to pause and resume derivers, e.g. during downstream maintenance
+	Control ControlConfig `yaml:"control"`
+
+	// DryRun runs the derivers without sending events to the outputs or persisting locations to the coordinator
+	DryRun bool `yaml:"dryRun" default:"false"`
 }
 
 func (c *Config) Validate() error {
+	if err := c.LogFormat.Validate(); err != nil {
+		return err
+	}
+
 	if c.Name == "" {
 		return errors.New("name is required")
 	}
 
+	if c.MetricsNamespace == "" {
+		return errors.New("metricsNamespace is required")
+	}
+
+	if !c.NTPDisabled {
+		if len(c.NTPServer) == 0 {
+			return errors.New("at least one ntpServer is required")
+		}
+
+		if c.NTPSyncInterval < 30*time.Second {
+			return errors.New("ntpSyncInterval must be at least 30s")
+		}
+	}
+
+	if c.ClientMetaRefreshInterval < 10*time.Second {
+		return errors.New("clientMetaRefreshInterval must be at least 10s")
+	}
+
+	if c.MaxEventsPerSecond < 0 {
+		return errors.New("maxEventsPerSecond must be greater than or equal to 0")
+	}
+
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", c.Timezone, err)
+	}
+
 	if err := c.Ethereum.Validate(); err != nil {
 		return err
 	}
 
-	for _, output := range c.Outputs {
+	// Output names key the sinks' event filters, so a duplicate would route one output's events through
+	// another's filter.
+	names := make(map[string]int, len(c.Outputs))
+
+	for i, output := range c.Outputs {
+		if output.Name == "" {
+			return fmt.Errorf("outputs[%d] name is required", i)
+		}
+
+		if first, ok := names[output.Name]; ok {
+			return fmt.Errorf("outputs[%d] and outputs[%d] are both named %q", first, i, output.Name)
+		}
+
+		names[output.Name] = i
+
 		if err := output.Validate(); err != nil {
 			return fmt.Errorf("invalid output config %s: %w", output.Name, err)
 		}
 	}
 
+	if c.DeadLetter != nil {
+		if err := c.DeadLetter.Validate(); err != nil {
+			return fmt.Errorf("invalid deadLetter config: %w", err)
+		}
+	}
+
+	if _, err := expandLabels(c.Labels); err != nil {
+		return err
+	}
+
+	if err := c.Control.Validate(); err != nil {
+		return fmt.Errorf("invalid control config: %w", err)
+	}
+
+	if c.Control.Enabled && c.MetricsAddr == "" {
+		return errors.New("control requires metricsAddr to be set")
+	}
+
+	if err := c.AsyncOutputs.Validate(); err != nil {
+		return fmt.Errorf("invalid asyncOutputs config: %w", err)
+	}
+
 	if err := c.Derivers.Validate(); err != nil {
 		return fmt.Errorf("invalid derivers config: %w", err)
 	}
@@ -73,23 +184,91 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func (c *Config) GetUserAgent() string {
+	if c.UserAgent != "" {
+		return c.UserAgent
+	}
+
+	return strings.ToLower(xatu.FullWithMode(xatu.ModeCannon))
+}
+
+// withUserAgent returns a copy of headers with the User-Agent set, unless the headers already set one.
+func withUserAgent(headers map[string]string, userAgent string) map[string]string {
+	out := make(map[string]string, len(headers)+1)
+
+	for k, v := range headers {
+		if strings.EqualFold(k, "User-Agent") {
+			userAgent = ""
+		}
+
+		out[k] = v
+	}
+
+	if userAgent != "" {
+		out["User-Agent"] = userAgent
+	}
+
+	return out
+}
+
 func (c *Config) CreateSinks(log logrus.FieldLogger) ([]output.Sink, error) {
 	sinks := make([]output.Sink, len(c.Outputs))
 
 	for i, out := range c.Outputs {
+		// The cannon routes events through each output's filter before handing them to the sink,
+		// so the sink itself is created without one.
 		sink, err := output.NewSink(out.Name,
 			out.SinkType,
 			out.Config,
 			log,
-			out.FilterConfig,
+			xatu.EventFilterConfig{},
 			processor.ShippingMethodSync,
+			c.GetUserAgent(),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		sinks[i] = sink
+		sinks[i] = output.WithBatching(sink, out.Batch, log)
 	}
 
 	return sinks, nil
 }
+
+func (c *Config) CreateDeadLetterSink(log logrus.FieldLogger) (output.Sink, error) {
+	if c.DeadLetter == nil {
+		return nil, nil
+	}
+
+	out := c.DeadLetter.Output
+
+	return output.NewSink(out.Name,
+		out.SinkType,
+		out.Config,
+		log,
+		out.FilterConfig,
+		processor.ShippingMethodSync,
+		c.GetUserAgent(),
+	)
+}
+
+// NTPServers is a list of NTP servers. It can be configured as either a single server or a list of servers.
+type NTPServers []string
+
+func (n *NTPServers) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var server string
+	if err := unmarshal(&server); err == nil {
+		*n = NTPServers{server}
+
+		return nil
+	}
+
+	var servers []string
+	if err := unmarshal(&servers); err != nil {
+		return err
+	}
+
+	*n = servers
+
+	return nil
+}
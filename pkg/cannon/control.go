@@ -0,0 +1,136 @@
+package cannon
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethpandaops/xatu/pkg/output/auth"
+)
+
+type ControlConfig struct {
+	// Enabled serves the deriver control endpoints on the metrics address
+	Enabled bool `yaml:"enabled" default:"false"`
+	// Auth is the bearer token callers must present
+	Auth auth.Config `yaml:",inline"`
+}
+
+func (c *ControlConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if err := c.Auth.Validate(); err != nil {
+		return err
+	}
+
+	if !c.Auth.Enabled() {
+		return errors.New("one of bearerToken, bearerTokenEnv or bearerTokenFile is required")
+	}
+
+	return nil
+}
+
+type controlResponse struct {
+	Paused map[string]bool `json:"paused"`
+}
+
+// handlePauseDerivers pauses the deriver named by the deriver query parameter, or every running deriver when
+// it's omitted. Paused derivers finish their in-flight location and then hold their location until resumed.
+func (c *Cannon) handlePauseDerivers(w http.ResponseWriter, r *http.Request) {
+	c.handleDeriverControl(w, r, true)
+}
+
+// handleResumeDerivers resumes the deriver named by the deriver query parameter, or every deriver when it's
+// omitted.
+func (c *Cannon) handleResumeDerivers(w http.ResponseWriter, r *http.Request) {
+	c.handleDeriverControl(w, r, false)
+}
+
+func (c *Cannon) handleDeriverControl(w http.ResponseWriter, r *http.Request, pause bool) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if err := c.authorizeControl(r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	names, err := c.controlTargets(r.URL.Query().Get("deriver"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	}
+
+	action := "Resumed"
+	if pause {
+		action = "Paused"
+	}
+
+	for _, name := range names {
+		gate := c.pauses.Gate(name)
+
+		if pause {
+			gate.Pause()
+		} else {
+			gate.Resume()
+		}
+
+		c.metrics.SetDeriverPaused(name, pause)
+
+		c.log.WithField("deriver", name).WithField("remote_addr", r.RemoteAddr).Infof("%s deriver", action)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(controlResponse{Paused: c.pauses.States()}); err != nil {
+		c.log.WithError(err).Debug("Failed to write deriver control response")
+	}
+}
+
+func (c *Cannon) authorizeControl(r *http.Request) error {
+	token, err := c.Config.Control.Auth.Token()
+	if err != nil {
+		c.log.WithError(err).Error("Failed to resolve control endpoint bearer token")
+
+		return errors.New("unauthorized")
+	}
+
+	presented := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+		return errors.New("unauthorized")
+	}
+
+	return nil
+}
+
+// controlTargets returns the names of the derivers a control request applies to. An empty name targets every
+// running deriver.
+func (c *Cannon) controlTargets(name string) ([]string, error) {
+	c.eventDeriversMu.Lock()
+	defer c.eventDeriversMu.Unlock()
+
+	names := make([]string, 0, len(c.eventDerivers))
+
+	for _, d := range c.eventDerivers {
+		if name == "" || strings.EqualFold(name, d.CannonType().String()) {
+			names = append(names, d.CannonType().String())
+		}
+	}
+
+	if name != "" && len(names) == 0 {
+		return nil, fmt.Errorf("deriver %s is not running", name)
+	}
+
+	return names, nil
+}
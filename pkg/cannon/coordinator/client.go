@@ -2,28 +2,60 @@ package coordinator
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"time"
 
+	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
+// ErrCoordinatorUnavailable is returned when a request to the coordinator has failed after exhausting all retries.
+var ErrCoordinatorUnavailable = errors.New("coordinator unavailable")
+
+// isUnavailable reports whether the coordinator failed to serve a request for a reason that may pass, as opposed
+// to rejecting it. Only these are retried and reported as ErrCoordinatorUnavailable.
+func isUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
 type Client struct {
 	config *Config
 	log    logrus.FieldLogger
 
 	conn *grpc.ClientConn
 	pb   xatu.CoordinatorClient
+
+	metrics *Metrics
+
+	// local is set when locations are persisted to disk instead of a coordinator server
+	local *localStore
+
+	// dryRun is set when locations should be read from the coordinator but only ever written to memory
+	dryRun *dryRunLocations
 }
 
-func New(config *Config, log logrus.FieldLogger) (*Client, error) {
+func New(config *Config, namespace string, registerer prometheus.Registerer, log logrus.FieldLogger) (*Client, error) {
 	if config == nil {
 		return nil, errors.New("config is required")
 	}
@@ -32,15 +64,30 @@ func New(config *Config, log logrus.FieldLogger) (*Client, error) {
 		return nil, err
 	}
 
+	if config.Type == TypeLocal {
+		local, err := newLocalStore(config.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		log.WithField("path", config.Path).Info("Using local coordinator store")
+
+		return &Client{
+			config: config,
+			log:    log,
+			local:  local,
+		}, nil
+	}
+
 	var opts []grpc.DialOption
 
 	if config.TLS {
-		host, _, err := net.SplitHostPort(config.Address)
+		tlsConfig, err := newTLSConfig(config)
 		if err != nil {
-			return nil, fmt.Errorf("fail to get host from address: %v", err)
+			return nil, err
 		}
 
-		opts = append(opts, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, host)))
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
@@ -53,10 +100,11 @@ func New(config *Config, log logrus.FieldLogger) (*Client, error) {
 	pbClient := xatu.NewCoordinatorClient(conn)
 
 	return &Client{
-		config: config,
-		log:    log,
-		conn:   conn,
-		pb:     pbClient,
+		config:  config,
+		log:     log,
+		conn:    conn,
+		pb:      pbClient,
+		metrics: NewMetrics(namespace, registerer),
 	}, nil
 }
 
@@ -64,7 +112,17 @@ func (c *Client) Start(ctx context.Context) error {
 	return nil
 }
 
+// EnableDryRun stops the client from writing locations to the coordinator. Locations are kept in memory
+// instead, and read back in preference to the coordinator's. Must be called before the client is shared.
+func (c *Client) EnableDryRun() {
+	c.dryRun = newDryRunLocations()
+}
+
 func (c *Client) Stop(ctx context.Context) error {
+	if c.conn == nil {
+		return nil
+	}
+
 	if err := c.conn.Close(); err != nil {
 		return err
 	}
@@ -73,6 +131,16 @@ func (c *Client) Stop(ctx context.Context) error {
 }
 
 func (c *Client) GetCannonLocation(ctx context.Context, typ xatu.CannonType, networkID string) (*xatu.CannonLocation, error) {
+	if c.dryRun != nil {
+		if location, ok := c.dryRun.get(typ, networkID); ok {
+			return location, nil
+		}
+	}
+
+	if c.local != nil {
+		return c.local.get(typ, networkID)
+	}
+
 	req := xatu.GetCannonLocationRequest{
 		Type:      typ,
 		NetworkId: networkID,
@@ -81,26 +149,180 @@ func (c *Client) GetCannonLocation(ctx context.Context, typ xatu.CannonType, net
 	md := metadata.New(c.config.Headers)
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	res, err := c.pb.GetCannonLocation(ctx, &req, grpc.UseCompressor(gzip.Name))
+	var location *xatu.CannonLocation
+
+	err := c.withRetry(ctx, "GetCannonLocation", func() error {
+		res, err := c.pb.GetCannonLocation(ctx, &req, grpc.UseCompressor(gzip.Name))
+		if err != nil {
+			return err
+		}
+
+		location = res.Location
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Location, nil
+	return location, nil
 }
 
 func (c *Client) UpsertCannonLocationRequest(ctx context.Context, location *xatu.CannonLocation) error {
+	if c.dryRun != nil {
+		c.dryRun.set(location)
+
+		return nil
+	}
+
+	if c.local != nil {
+		return c.local.upsert(location)
+	}
+
 	req := xatu.UpsertCannonLocationRequest{
 		Location: location,
+		Confirm:  c.config.ConfirmLocationUpdates,
 	}
 
 	md := metadata.New(c.config.Headers)
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	_, err := c.pb.UpsertCannonLocation(ctx, &req, grpc.UseCompressor(gzip.Name))
+	var confirmed *xatu.CannonLocation
+
+	err := c.withRetry(ctx, "UpsertCannonLocation", func() error {
+		res, err := c.pb.UpsertCannonLocation(ctx, &req, grpc.UseCompressor(gzip.Name))
+		if err != nil {
+			return err
+		}
+
+		confirmed = res.GetLocation()
+
+		return nil
+	})
 	if err != nil {
+		// Coordinators that support confirmLocationUpdates answer NotFound when the location can't be read back.
+		if c.config.ConfirmLocationUpdates && status.Code(err) == codes.NotFound {
+			return fmt.Errorf("coordinator did not store the location update: %w", err)
+		}
+
+		return err
+	}
+
+	if c.config.ConfirmLocationUpdates {
+		c.checkConfirmedLocation(location, confirmed)
+	}
+
+	return nil
+}
+
+// checkConfirmedLocation compares the location the coordinator reports as stored with the one that was sent.
+// A mismatch means another writer, e.g. a cannon talking to a different coordinator replica, owns the location.
+func (c *Client) checkConfirmedLocation(sent, confirmed *xatu.CannonLocation) {
+	log := c.log.
+		WithField("type", sent.GetType().String()).
+		WithField("network_id", sent.GetNetworkId())
+
+	if confirmed == nil {
+		// Coordinators that support confirmLocationUpdates always return the stored location or NotFound.
+		log.Warn("Coordinator did not confirm the location update. The coordinator may be too old to support confirmLocationUpdates")
+
+		return
+	}
+
+	if proto.Equal(sent, confirmed) {
+		return
+	}
+
+	c.metrics.IncLocationMismatch(sent.GetType().String())
+
+	log.
+		WithField("sent", protojson.Format(sent)).
+		WithField("stored", protojson.Format(confirmed)).
+		Error("Coordinator stored a different location than the one sent. Another cannon or coordinator replica may be writing the same location")
+}
+
+func (c *Client) withRetry(ctx context.Context, method string, operation func() error) error {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = c.config.Retry.BaseDelay
+	bo.MaxInterval = c.config.Retry.MaxDelay
+	bo.RandomizationFactor = c.config.Retry.Jitter
+	bo.MaxElapsedTime = 0
+
+	b := backoff.WithContext(backoff.WithMaxRetries(bo, c.config.Retry.MaxRetries), ctx)
+
+	// Each attempt is measured on its own so the histogram reflects the coordinator's round-trip time
+	// rather than time spent backing off.
+	attempt := func() error {
+		start := time.Now()
+
+		err := operation()
+
+		c.metrics.ObserveRequestDuration(method, time.Since(start))
+
+		if err != nil {
+			c.metrics.IncRequestErrors(method)
+
+			if !isUnavailable(err) {
+				return backoff.Permanent(err)
+			}
+		}
+
 		return err
 	}
 
+	if err := backoff.RetryNotify(attempt, b, func(err error, timer time.Duration) {
+		c.log.WithError(err).WithField("method", method).WithField("next_attempt", timer).Warn("Coordinator request failed, retrying")
+	}); err != nil {
+		if ctx.Err() != nil || !isUnavailable(err) {
+			return err
+		}
+
+		return fmt.Errorf("%w: %s failed: %v", ErrCoordinatorUnavailable, method, err)
+	}
+
 	return nil
 }
+
+func newTLSConfig(config *Config) (*tls.Config, error) {
+	serverName := config.TLSClientConfig.ServerName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(config.Address)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get host from address: %v", err)
+		}
+
+		serverName = host
+	}
+
+	//nolint:gosec // InsecureSkipVerify is opt-in.
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         serverName,
+		InsecureSkipVerify: config.TLSClientConfig.InsecureSkipVerify,
+	}
+
+	if config.TLSClientConfig.CACertificatePath != "" {
+		ca, err := os.ReadFile(config.TLSClientConfig.CACertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse ca certificate %s", config.TLSClientConfig.CACertificatePath)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.TLSClientConfig.CertificatePath != "" {
+		cert, err := tls.LoadX509KeyPair(config.TLSClientConfig.CertificatePath, config.TLSClientConfig.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -2,18 +2,91 @@ package coordinator
 
 import (
 	"errors"
+	"fmt"
+	"time"
+)
+
+type Type string
+
+const (
+	// TypeServer stores locations in a xatu server coordinator.
+	TypeServer Type = "server"
+	// TypeLocal stores locations in a file on disk.
+	TypeLocal Type = "local"
 )
 
 type Config struct {
+	Type    Type              `yaml:"type" default:"server"`
+	Path    string            `yaml:"path"`
 	Address string            `yaml:"address"`
 	Headers map[string]string `yaml:"headers"`
 	TLS     bool              `yaml:"tls" default:"false"`
+	// TLSClientConfig configures the transport security used when TLS is enabled.
+	TLSClientConfig TLSClientConfig `yaml:"tlsClientConfig"`
+	Retry           RetryConfig     `yaml:"retry"`
+	// ConfirmLocationUpdates asks the coordinator to return the stored location after every update and logs
+	// an error when it differs from the location that was sent.
+	ConfirmLocationUpdates bool `yaml:"confirmLocationUpdates" default:"false"`
+}
+
+type TLSClientConfig struct {
+	// CACertificatePath is a PEM bundle used to verify the coordinator. Defaults to the system roots.
+	CACertificatePath string `yaml:"caCertificatePath"`
+	// CertificatePath and KeyPath are the client certificate presented to the coordinator for mTLS.
+	CertificatePath string `yaml:"certificatePath"`
+	KeyPath         string `yaml:"keyPath"`
+	// ServerName overrides the name used to verify the coordinator certificate. Defaults to the host of the address.
+	ServerName         string `yaml:"serverName"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify" default:"false"`
+}
+
+type RetryConfig struct {
+	MaxRetries uint64        `yaml:"maxRetries" default:"5"`
+	BaseDelay  time.Duration `yaml:"baseDelay" default:"1s"`
+	MaxDelay   time.Duration `yaml:"maxDelay" default:"30s"`
+	Jitter     float64       `yaml:"jitter" default:"0.5"`
 }
 
 func (c *Config) Validate() error {
+	switch c.Type {
+	case TypeLocal:
+		if c.Path == "" {
+			return errors.New("path is required when type is local")
+		}
+
+		return nil
+	case TypeServer, "":
+	default:
+		return fmt.Errorf("unknown coordinator type: %s", c.Type)
+	}
+
 	if c.Address == "" {
 		return errors.New("address is required")
 	}
 
+	if (c.TLSClientConfig.CertificatePath == "") != (c.TLSClientConfig.KeyPath == "") {
+		return errors.New("tlsClientConfig.certificatePath and tlsClientConfig.keyPath must be set together")
+	}
+
+	if err := c.Retry.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *RetryConfig) Validate() error {
+	if c.BaseDelay <= 0 {
+		return errors.New("retry.baseDelay must be greater than 0")
+	}
+
+	if c.MaxDelay < c.BaseDelay {
+		return errors.New("retry.maxDelay must be greater than or equal to retry.baseDelay")
+	}
+
+	if c.Jitter < 0 || c.Jitter > 1 {
+		return errors.New("retry.jitter must be between 0 and 1")
+	}
+
 	return nil
 }
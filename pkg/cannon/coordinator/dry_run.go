@@ -0,0 +1,40 @@
+package coordinator
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+)
+
+// dryRunLocations holds cannon locations in memory so a dry run never writes to the coordinator.
+type dryRunLocations struct {
+	mu        sync.Mutex
+	locations map[string]*xatu.CannonLocation
+}
+
+func newDryRunLocations() *dryRunLocations {
+	return &dryRunLocations{
+		locations: make(map[string]*xatu.CannonLocation),
+	}
+}
+
+func dryRunKey(typ xatu.CannonType, networkID string) string {
+	return fmt.Sprintf("%s/%s", networkID, typ.String())
+}
+
+func (d *dryRunLocations) get(typ xatu.CannonType, networkID string) (*xatu.CannonLocation, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	location, ok := d.locations[dryRunKey(typ, networkID)]
+
+	return location, ok
+}
+
+func (d *dryRunLocations) set(location *xatu.CannonLocation) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.locations[dryRunKey(location.GetType(), location.GetNetworkId())] = location
+}
@@ -0,0 +1,111 @@
+package coordinator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// localStore persists cannon locations to a json file on disk so cannon can run without a coordinator server.
+type localStore struct {
+	path string
+
+	mu        sync.Mutex
+	locations map[string]json.RawMessage
+}
+
+func newLocalStore(path string) (*localStore, error) {
+	s := &localStore{
+		path:      path,
+		locations: make(map[string]json.RawMessage),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+
+		return nil, fmt.Errorf("failed to read local coordinator store: %w", err)
+	}
+
+	if len(data) == 0 {
+		return s, nil
+	}
+
+	if err := json.Unmarshal(data, &s.locations); err != nil {
+		return nil, fmt.Errorf("failed to parse local coordinator store %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+func localStoreKey(typ xatu.CannonType, networkID string) string {
+	return fmt.Sprintf("%s/%s", networkID, typ.String())
+}
+
+func (s *localStore) get(typ xatu.CannonType, networkID string) (*xatu.CannonLocation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, ok := s.locations[localStoreKey(typ, networkID)]
+	if !ok {
+		return nil, nil
+	}
+
+	location := &xatu.CannonLocation{}
+	if err := protojson.Unmarshal(raw, location); err != nil {
+		return nil, fmt.Errorf("failed to parse stored location: %w", err)
+	}
+
+	return location, nil
+}
+
+func (s *localStore) upsert(location *xatu.CannonLocation) error {
+	raw, err := protojson.Marshal(location)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locations[localStoreKey(location.GetType(), location.GetNetworkId())] = raw
+
+	return s.flush()
+}
+
+// flush writes the store to a temporary file and renames it over the previous one so a crash never leaves a
+// partially written store behind. Must be called with mu held.
+func (s *localStore) flush() error {
+	data, err := json.MarshalIndent(s.locations, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary local coordinator store: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
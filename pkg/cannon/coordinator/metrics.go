@@ -0,0 +1,54 @@
+package coordinator
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Metrics struct {
+	requestDuration  *prometheus.HistogramVec
+	requestErrors    *prometheus.CounterVec
+	locationMismatch *prometheus.CounterVec
+}
+
+func NewMetrics(namespace string, registerer prometheus.Registerer) *Metrics {
+	namespace += "_coordinator"
+
+	m := &Metrics{
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "The duration of requests made to the coordinator, per attempt",
+			Buckets:   prometheus.ExponentialBuckets(0.005, 2, 12),
+		}, []string{"method"}),
+		requestErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_errors_total",
+			Help:      "The number of failed request attempts made to the coordinator",
+		}, []string{"method"}),
+		locationMismatch: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "location_mismatch_total",
+			Help:      "The number of location updates where the coordinator confirmed a different location than was sent",
+		}, []string{"type"}),
+	}
+
+	registerer.MustRegister(m.requestDuration)
+	registerer.MustRegister(m.requestErrors)
+	registerer.MustRegister(m.locationMismatch)
+
+	return m
+}
+
+func (m *Metrics) ObserveRequestDuration(method string, duration time.Duration) {
+	m.requestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+func (m *Metrics) IncRequestErrors(method string) {
+	m.requestErrors.WithLabelValues(method).Inc()
+}
+
+func (m *Metrics) IncLocationMismatch(cannonType string) {
+	m.locationMismatch.WithLabelValues(cannonType).Inc()
+}
@@ -0,0 +1,118 @@
+package cannon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/cannon/deriver"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+)
+
+const coverageTimeout = 10 * time.Second
+
+type coverageResponse struct {
+	FinalizedSlot uint64            `json:"finalizedSlot"`
+	Derivers      []deriverCoverage `json:"derivers"`
+}
+
+// deriverCoverage is the location a deriver has stored in the coordinator. Epoch is omitted for blockprint,
+// which tracks slots, and every location field is omitted until the deriver has stored its first location.
+type deriverCoverage struct {
+	Type     string  `json:"type"`
+	Epoch    *uint64 `json:"epoch,omitempty"`
+	Slot     *uint64 `json:"slot,omitempty"`
+	LagSlots *uint64 `json:"lagSlots,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// handleCoverage reports how far each running deriver has progressed towards the finalized slot, according to
+// the locations stored in the coordinator. Filter by deriver with ?type=<cannon type>.
+func (c *Cannon) handleCoverage(w http.ResponseWriter, r *http.Request) {
+	c.eventDeriversMu.Lock()
+	derivers := append([]deriver.EventDeriver{}, c.eventDerivers...)
+	deps := c.deriverDeps
+	c.eventDeriversMu.Unlock()
+
+	if deps == nil {
+		http.Error(w, "derivers have not started yet", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	finalizedSlot, err := c.finalizedSlot()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), coverageTimeout)
+	defer cancel()
+
+	filter := r.URL.Query().Get("type")
+
+	response := coverageResponse{
+		FinalizedSlot: finalizedSlot,
+		Derivers:      []deriverCoverage{},
+	}
+
+	for _, d := range derivers {
+		if filter != "" && filter != d.CannonType().String() {
+			continue
+		}
+
+		coverage := deriverCoverage{
+			Type: d.CannonType().String(),
+		}
+
+		if err := c.fillDeriverCoverage(ctx, &coverage, d, deps.networkID, finalizedSlot); err != nil {
+			coverage.Error = err.Error()
+		}
+
+		response.Derivers = append(response.Derivers, coverage)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		c.log.WithError(err).Debug("Failed to write coverage response")
+	}
+}
+
+func (c *Cannon) fillDeriverCoverage(ctx context.Context, coverage *deriverCoverage, d deriver.EventDeriver, networkID string, finalizedSlot uint64) error {
+	location, err := c.coordinatorClient.GetCannonLocation(ctx, d.CannonType(), networkID)
+	if err != nil {
+		return err
+	}
+
+	if location == nil {
+		return nil
+	}
+
+	value, err := iterator.LocationValue(location)
+	if err != nil {
+		return err
+	}
+
+	slot, err := c.locationSlot(d.CannonType(), value)
+	if err != nil {
+		return err
+	}
+
+	if d.CannonType() != xatu.CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION {
+		coverage.Epoch = &value
+	}
+
+	lag := uint64(0)
+	if slot < finalizedSlot {
+		lag = finalizedSlot - slot
+	}
+
+	coverage.Slot = &slot
+	coverage.LagSlots = &lag
+
+	return nil
+}
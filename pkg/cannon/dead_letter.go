@@ -0,0 +1,178 @@
+package cannon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/output"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	deadLetterLabelSinkName = "xatu_dead_letter_sink_name"
+	deadLetterLabelSinkType = "xatu_dead_letter_sink_type"
+	deadLetterLabelError    = "xatu_dead_letter_error"
+)
+
+type DeadLetterConfig struct {
+	// Output is the sink that receives event batches a primary sink failed to handle
+	Output output.Config `yaml:"output"`
+	// MaxRetries is how many times a primary sink is retried before its batch is dead-lettered
+	MaxRetries int `yaml:"maxRetries" default:"3"`
+	// RetryInterval is the delay between retries against the primary sink
+	RetryInterval time.Duration `yaml:"retryInterval" default:"1s"`
+}
+
+func (c *DeadLetterConfig) Validate() error {
+	if c.Output.Name == "" {
+		return errors.New("output.name is required")
+	}
+
+	if err := c.Output.Validate(); err != nil {
+		return fmt.Errorf("invalid output config: %w", err)
+	}
+
+	if c.MaxRetries < 0 {
+		return errors.New("maxRetries must be greater than or equal to 0")
+	}
+
+	return nil
+}
+
+// sendToSink hands the events to the sink, retrying and then falling back to
+// the dead-letter sink if one is configured.
+// Events a sink permanently rejects are not retried. They are dead-lettered, or dropped when there is no
+// dead-letter sink so a bad batch can't hold up its deriver forever.
+func (c *Cannon) sendToSink(ctx context.Context, sink output.Sink, events []*xatu.DecoratedEvent) error {
+	err := c.handToSink(ctx, sink, events)
+	if err == nil {
+		return nil
+	}
+
+	if output.IsPermanent(err) && c.deadLetterSink == nil {
+		c.log.
+			WithError(err).
+			WithField("sink", sink.Name()).
+			WithField("events", len(events)).
+			Error("Sink permanently rejected decorated events, dropping them")
+
+		network := string(c.beacon.Metadata().Network.Name)
+
+		for _, event := range events {
+			c.metrics.AddSinkDroppedEvent(1, sink.Type(), event, network)
+		}
+
+		return nil
+	}
+
+	if c.deadLetterSink == nil {
+		return err
+	}
+
+	for attempt := 1; attempt <= c.Config.DeadLetter.MaxRetries && !output.IsPermanent(err); attempt++ {
+		c.log.
+			WithError(err).
+			WithField("sink", sink.Name()).
+			WithField("attempt", attempt).
+			Warn("Failed to handle decorated events in sink, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.Config.DeadLetter.RetryInterval):
+		}
+
+		if err = c.handToSink(ctx, sink, events); err == nil {
+			return nil
+		}
+	}
+
+	return c.sendToDeadLetterSink(ctx, sink, events, err)
+}
+
+// sendToDeadLetterSink hands the events a sink failed to handle to the dead-letter sink.
+func (c *Cannon) sendToDeadLetterSink(ctx context.Context, sink output.Sink, events []*xatu.DecoratedEvent, err error) error {
+	c.log.
+		WithError(err).
+		WithField("sink", sink.Name()).
+		WithField("events", len(events)).
+		Error("Sink failed to handle decorated events, sending them to the dead-letter sink")
+
+	network := string(c.beacon.Metadata().Network.Name)
+
+	if dlErr := c.deadLetterSink.HandleNewDecoratedEvents(ctx, newDeadLetterEvents(events, sink, err)); dlErr != nil {
+		c.metrics.AddDeadLetterFailedEvents(len(events), sink.Name(), network)
+
+		return fmt.Errorf("failed to send events to dead-letter sink: %w (sink error: %s)", dlErr, err.Error())
+	}
+
+	c.metrics.AddDeadLetteredEvents(len(events), sink.Name(), network)
+
+	return nil
+}
+
+// deadLetterRejectedBatches routes the batches a BatchedSink's sink permanently rejects to the dead-letter sink.
+// Those batches never reach sendToSink because the BatchedSink has already accepted them from the caller.
+func (c *Cannon) deadLetterRejectedBatches() {
+	if c.deadLetterSink == nil {
+		return
+	}
+
+	for _, sink := range c.sinks {
+		batched, ok := sink.(*output.BatchedSink)
+		if !ok {
+			continue
+		}
+
+		batched.OnRejected(func(ctx context.Context, events []*xatu.DecoratedEvent, err error) {
+			if dlErr := c.sendToDeadLetterSink(ctx, batched, events, err); dlErr != nil {
+				c.log.
+					WithError(dlErr).
+					WithField("sink", batched.Name()).
+					WithField("events", len(events)).
+					Error("Failed to dead-letter permanently rejected events, dropping them")
+
+				network := string(c.beacon.Metadata().Network.Name)
+
+				for _, event := range events {
+					c.metrics.AddSinkDroppedEvent(1, batched.Type(), event, network)
+				}
+			}
+		})
+	}
+}
+
+// newDeadLetterEvents copies the events and labels them with the sink that failed and why.
+func newDeadLetterEvents(events []*xatu.DecoratedEvent, sink output.Sink, err error) []*xatu.DecoratedEvent {
+	deadLettered := make([]*xatu.DecoratedEvent, 0, len(events))
+
+	for _, event := range events {
+		clone, ok := proto.Clone(event).(*xatu.DecoratedEvent)
+		if !ok {
+			continue
+		}
+
+		if clone.Meta == nil {
+			clone.Meta = &xatu.Meta{}
+		}
+
+		if clone.Meta.Client == nil {
+			clone.Meta.Client = &xatu.ClientMeta{}
+		}
+
+		if clone.Meta.Client.Labels == nil {
+			clone.Meta.Client.Labels = make(map[string]string)
+		}
+
+		clone.Meta.Client.Labels[deadLetterLabelSinkName] = sink.Name()
+		clone.Meta.Client.Labels[deadLetterLabelSinkType] = sink.Type()
+		clone.Meta.Client.Labels[deadLetterLabelError] = err.Error()
+
+		deadLettered = append(deadLettered, clone)
+	}
+
+	return deadLettered
+}
@@ -0,0 +1,26 @@
+package deadline
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	// SlotDeadline is how long a single slot may take to process. 0 disables the deadline.
+	SlotDeadline time.Duration `yaml:"slotDeadline"`
+	// SkipSlotsPastDeadline skips a slot that exceeds the deadline so the deriver keeps moving. The slot is
+	// recorded as skipped and persisted to the derivers' skippedSlotsPath. When disabled the slot fails and is retried.
+	SkipSlotsPastDeadline bool `yaml:"skipSlotsPastDeadline" default:"false"`
+}
+
+func (c *Config) Validate() error {
+	if c.SlotDeadline < 0 {
+		return errors.New("slotDeadline must not be negative")
+	}
+
+	if c.SkipSlotsPastDeadline && c.SlotDeadline == 0 {
+		return errors.New("skipSlotsPastDeadline requires slotDeadline to be set")
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package deadline
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type Metrics struct {
+	exceeded *prometheus.CounterVec
+}
+
+func NewMetrics(namespace string, registerer prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		exceeded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "slot_deadline_exceeded_total",
+			Help:      "The number of slots that took longer than the deriver's slot deadline to process",
+		}, []string{"cannon_type", "skipped"}),
+	}
+
+	registerer.MustRegister(m.exceeded)
+
+	return m
+}
+
+func (m *Metrics) IncExceeded(cannonType string, skipped bool) {
+	label := "false"
+	if skipped {
+		label = "true"
+	}
+
+	m.exceeded.WithLabelValues(cannonType, label).Inc()
+}
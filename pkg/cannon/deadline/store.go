@@ -0,0 +1,61 @@
+package deadline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// loadSkippedSlots reads the skipped slots persisted at path. A missing file has no skipped slots.
+func loadSkippedSlots(path string) ([]SkippedSlot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []SkippedSlot{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to read skipped slots: %w", err)
+	}
+
+	slots := []SkippedSlot{}
+
+	if len(data) == 0 {
+		return slots, nil
+	}
+
+	if err := json.Unmarshal(data, &slots); err != nil {
+		return nil, fmt.Errorf("failed to parse skipped slots %s: %w", path, err)
+	}
+
+	return slots, nil
+}
+
+// saveSkippedSlots writes the skipped slots to a temporary file and renames it over the previous one so a crash
+// never leaves a partially written file behind.
+func saveSkippedSlots(path string, slots []SkippedSlot) error {
+	data, err := json.MarshalIndent(slots, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary skipped slots file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
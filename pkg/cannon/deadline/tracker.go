@@ -0,0 +1,166 @@
+package deadline
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var ErrSlotDeadlineExceeded = errors.New("slot deadline exceeded")
+
+type SkippedSlot struct {
+	CannonType string    `json:"cannonType"`
+	Slot       uint64    `json:"slot"`
+	SkippedAt  time.Time `json:"skippedAt"`
+}
+
+// Tracker enforces the per-deriver slot deadline and keeps the slots that were skipped because of it, so
+// they can be reprocessed later. Skipped slots are persisted to path, as the deriver's location moves past them.
+type Tracker struct {
+	log     logrus.FieldLogger
+	metrics *Metrics
+	path    string
+
+	mu      sync.Mutex
+	skipped []SkippedSlot
+}
+
+// NewTracker creates a tracker that persists skipped slots to path. An empty path keeps them in memory only, which
+// is only valid while no deriver skips slots.
+func NewTracker(namespace string, registerer prometheus.Registerer, log logrus.FieldLogger, path string) (*Tracker, error) {
+	t := &Tracker{
+		log:     log.WithField("module", "cannon/deadline"),
+		metrics: NewMetrics(namespace, registerer),
+		path:    path,
+		skipped: []SkippedSlot{},
+	}
+
+	if path == "" {
+		return t, nil
+	}
+
+	skipped, err := loadSkippedSlots(path)
+	if err != nil {
+		return nil, err
+	}
+
+	t.skipped = skipped
+
+	if len(skipped) > 0 {
+		t.log.WithField("skipped_slots", len(skipped)).WithField("path", path).Warn("Loaded slots previously skipped for exceeding their deadline")
+	}
+
+	return t, nil
+}
+
+// Process runs fn for the slot, giving up once the deadline in config passes. fn's context is cancelled at
+// the deadline but fn is not waited for, so a slot stuck outside of a context aware call can't hold up the
+// deriver.
+func (t *Tracker) Process(ctx context.Context, cannonType xatu.CannonType, config *Config, slot phase0.Slot, fn func(ctx context.Context) ([]*xatu.DecoratedEvent, error)) ([]*xatu.DecoratedEvent, error) {
+	if t == nil || config.SlotDeadline == 0 {
+		return fn(ctx)
+	}
+
+	dctx, cancel := context.WithTimeout(ctx, config.SlotDeadline)
+	defer cancel()
+
+	type result struct {
+		events []*xatu.DecoratedEvent
+		err    error
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		events, err := fn(dctx)
+
+		done <- result{events: events, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.err == nil || !errors.Is(dctx.Err(), context.DeadlineExceeded) {
+			return r.events, r.err
+		}
+	case <-dctx.Done():
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	t.metrics.IncExceeded(cannonType.String(), config.SkipSlotsPastDeadline)
+
+	log := t.log.
+		WithField("cannon_type", cannonType.String()).
+		WithField("slot", slot).
+		WithField("deadline", config.SlotDeadline)
+
+	if !config.SkipSlotsPastDeadline {
+		log.Warn("Slot exceeded its processing deadline, retrying")
+
+		return nil, errors.Wrapf(ErrSlotDeadlineExceeded, "slot %d took longer than %s", slot, config.SlotDeadline)
+	}
+
+	// The slot is only skipped once it has been persisted, otherwise the deriver would move past it without a trace.
+	if err := t.record(cannonType, slot); err != nil {
+		log.WithError(err).Error("Failed to persist skipped slot, retrying the slot")
+
+		return nil, errors.Wrapf(err, "failed to record skipped slot %d", slot)
+	}
+
+	log.Error("Slot exceeded its processing deadline, skipping it. Events for this slot will be missing until it is reprocessed")
+
+	return []*xatu.DecoratedEvent{}, nil
+}
+
+func (t *Tracker) record(cannonType xatu.CannonType, slot phase0.Slot) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, s := range t.skipped {
+		if s.CannonType == cannonType.String() && s.Slot == uint64(slot) {
+			return nil
+		}
+	}
+
+	skipped := make([]SkippedSlot, len(t.skipped), len(t.skipped)+1)
+	copy(skipped, t.skipped)
+
+	skipped = append(skipped, SkippedSlot{
+		CannonType: cannonType.String(),
+		Slot:       uint64(slot),
+		SkippedAt:  time.Now(),
+	})
+
+	if t.path != "" {
+		if err := saveSkippedSlots(t.path, skipped); err != nil {
+			return err
+		}
+	}
+
+	t.skipped = skipped
+
+	return nil
+}
+
+// SkippedSlots returns every slot skipped so far, including those persisted before a restart, oldest first. An empty cannonType returns every type.
+func (t *Tracker) SkippedSlots(cannonType string) []SkippedSlot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	slots := make([]SkippedSlot, 0, len(t.skipped))
+
+	for _, s := range t.skipped {
+		if cannonType == "" || s.CannonType == cannonType {
+			slots = append(slots, s)
+		}
+	}
+
+	return slots
+}
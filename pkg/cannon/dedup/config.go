@@ -0,0 +1,31 @@
+package dedup
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	// Enabled suppresses events the deriver has already emitted within the window.
+	Enabled bool `yaml:"enabled" default:"false"`
+	// Window is how long an emitted event is remembered for.
+	Window time.Duration `yaml:"window" default:"1h"`
+	// MaxSize bounds the number of remembered events. The least recently seen are forgotten first.
+	MaxSize uint64 `yaml:"maxSize" default:"100000"`
+}
+
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Window <= 0 {
+		return errors.New("dedup.window must be greater than 0")
+	}
+
+	if c.MaxSize == 0 {
+		return errors.New("dedup.maxSize must be greater than 0")
+	}
+
+	return nil
+}
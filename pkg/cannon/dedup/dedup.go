@@ -0,0 +1,95 @@
+package dedup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/jellydator/ttlcache/v3"
+	"google.golang.org/protobuf/proto"
+)
+
+// Wrap returns an events callback that drops events already handed to fn within the configured window.
+// Events are only remembered once fn succeeds so a failed batch is not suppressed when it is retried.
+// fn is returned as is when dedup is disabled.
+func Wrap(config *Config, metrics *Metrics, cannonType xatu.CannonType, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	if !config.Enabled {
+		return fn
+	}
+
+	seen := ttlcache.New[string, struct{}](
+		ttlcache.WithTTL[string, struct{}](config.Window),
+		ttlcache.WithCapacity[string, struct{}](config.MaxSize),
+	)
+
+	return func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+		unique := make([]*xatu.DecoratedEvent, 0, len(events))
+		keys := make([]string, 0, len(events))
+		inBatch := make(map[string]struct{}, len(events))
+
+		for _, event := range events {
+			key, err := Key(event)
+			if err != nil {
+				return err
+			}
+
+			if _, ok := inBatch[key]; ok {
+				continue
+			}
+
+			if seen.Get(key) != nil {
+				continue
+			}
+
+			inBatch[key] = struct{}{}
+
+			unique = append(unique, event)
+			keys = append(keys, key)
+		}
+
+		if suppressed := len(events) - len(unique); suppressed > 0 {
+			metrics.AddSuppressed(suppressed, cannonType.String())
+		}
+
+		if len(unique) == 0 {
+			return nil
+		}
+
+		if err := fn(ctx, unique); err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			seen.Set(key, struct{}{}, ttlcache.DefaultTTL)
+		}
+
+		return nil
+	}
+}
+
+// Key returns a deterministic identifier for the logical event. The event id and timestamps differ on every
+// emission so the key only covers the event name, the payload and the additional data, which carries the
+// block the event was derived from and its position within it.
+func Key(event *xatu.DecoratedEvent) (string, error) {
+	logical := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name: event.GetEvent().GetName(),
+		},
+		Meta: &xatu.Meta{
+			Client: &xatu.ClientMeta{
+				AdditionalData: event.GetMeta().GetClient().GetAdditionalData(),
+			},
+		},
+		Data: event.GetData(),
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(logical)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,143 @@
+package dedup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDeposit returns a deposit event for the pubkey. Every call gets a new event id, like a deriver emitting the
+// same deposit again.
+func newDeposit(pubkey string) *xatu.DecoratedEvent {
+	return &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name: xatu.Event_BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT,
+			Id:   fmt.Sprintf("%s-%d", pubkey, time.Now().UnixNano()),
+		},
+		Data: &xatu.DecoratedEvent_EthV2BeaconBlockDeposit{
+			EthV2BeaconBlockDeposit: &v1.DepositV2{
+				Data: &v1.DepositV2_Data{Pubkey: pubkey},
+			},
+		},
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name string
+		// batches are handed to the wrapped callback in order, with failed set when fn should fail that batch.
+		batches  [][]string
+		failed   []bool
+		expected [][]string
+	}{
+		{
+			name:     "unique events",
+			batches:  [][]string{{"a", "b"}, {"c"}},
+			expected: [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name:     "duplicate within a batch",
+			batches:  [][]string{{"a", "a", "b"}},
+			expected: [][]string{{"a", "b"}},
+		},
+		{
+			name:     "duplicate across batches",
+			batches:  [][]string{{"a", "b"}, {"b", "c"}, {"a"}},
+			expected: [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name:     "failed batch is not remembered",
+			batches:  [][]string{{"a"}, {"a"}},
+			failed:   []bool{true, false},
+			expected: [][]string{{"a"}, {"a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := [][]string{}
+			call := 0
+
+			fn := Wrap(
+				&Config{Enabled: true, Window: time.Hour, MaxSize: 100},
+				NewMetrics("test", prometheus.NewRegistry()),
+				xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT,
+				func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+					pubkeys := make([]string, 0, len(events))
+
+					for _, event := range events {
+						pubkeys = append(pubkeys, event.GetEthV2BeaconBlockDeposit().GetData().GetPubkey())
+					}
+
+					received = append(received, pubkeys)
+
+					failed := call < len(tt.failed) && tt.failed[call]
+					call++
+
+					if failed {
+						return errors.New("sink unavailable")
+					}
+
+					return nil
+				},
+			)
+
+			for i, batch := range tt.batches {
+				events := make([]*xatu.DecoratedEvent, 0, len(batch))
+
+				for _, pubkey := range batch {
+					events = append(events, newDeposit(pubkey))
+				}
+
+				err := fn(context.Background(), events)
+
+				if i < len(tt.failed) && tt.failed[i] {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			}
+
+			assert.Equal(t, tt.expected, received)
+		})
+	}
+}
+
+func TestWrapDisabled(t *testing.T) {
+	calls := 0
+
+	fn := Wrap(&Config{Enabled: false}, nil, xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT, func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+		calls++
+
+		assert.Len(t, events, 2)
+
+		return nil
+	})
+
+	require.NoError(t, fn(context.Background(), []*xatu.DecoratedEvent{newDeposit("a"), newDeposit("a")}))
+	require.NoError(t, fn(context.Background(), []*xatu.DecoratedEvent{newDeposit("a"), newDeposit("a")}))
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestKeyIgnoresEventID(t *testing.T) {
+	a, err := Key(newDeposit("a"))
+	require.NoError(t, err)
+
+	again, err := Key(newDeposit("a"))
+	require.NoError(t, err)
+
+	other, err := Key(newDeposit("b"))
+	require.NoError(t, err)
+
+	assert.Equal(t, a, again)
+	assert.NotEqual(t, a, other)
+}
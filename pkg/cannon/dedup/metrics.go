@@ -0,0 +1,25 @@
+package dedup
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type Metrics struct {
+	suppressed *prometheus.CounterVec
+}
+
+func NewMetrics(namespace string, registerer prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		suppressed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "deriver_duplicate_events_suppressed_total",
+			Help:      "Total number of events a deriver emitted again within its dedup window and were suppressed",
+		}, []string{"type"}),
+	}
+
+	registerer.MustRegister(m.suppressed)
+
+	return m
+}
+
+func (m *Metrics) AddSuppressed(count int, cannonType string) {
+	m.suppressed.WithLabelValues(cannonType).Add(float64(count))
+}
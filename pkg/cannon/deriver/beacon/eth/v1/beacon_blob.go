@@ -9,7 +9,12 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum/services"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
@@ -30,25 +35,39 @@ const (
 )
 
 type BeaconBlobDeriverConfig struct {
-	Enabled bool `yaml:"enabled" default:"false"`
+	Enabled        bool               `yaml:"enabled" default:"false"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
 }
 
 type BeaconBlobDeriver struct {
-	log               logrus.FieldLogger
-	cfg               *BeaconBlobDeriverConfig
-	iterator          *iterator.CheckpointIterator
-	onEventsCallbacks []func(ctx context.Context, events []*xatu.DecoratedEvent) error
-	beacon            *ethereum.BeaconNode
-	clientMeta        *xatu.ClientMeta
+	log                 logrus.FieldLogger
+	cfg                 *BeaconBlobDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
 }
 
-func NewBeaconBlobDeriver(log logrus.FieldLogger, config *BeaconBlobDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, clientMeta *xatu.ClientMeta) *BeaconBlobDeriver {
+func NewBeaconBlobDeriver(log logrus.FieldLogger, config *BeaconBlobDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *BeaconBlobDeriver {
 	return &BeaconBlobDeriver{
-		log:        log.WithField("module", "cannon/event/beacon/eth/v1/beacon_blob"),
-		cfg:        config,
-		iterator:   iter,
-		beacon:     beacon,
-		clientMeta: clientMeta,
+		log:           log.WithField("module", "cannon/event/beacon/eth/v1/beacon_blob"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
 	}
 }
 
@@ -60,10 +79,25 @@ func (b *BeaconBlobDeriver) Name() string {
 	return BeaconBlobDeriverName.String()
 }
 
+// MinNodeVersions are the first releases of each client that serve the blob sidecars endpoint.
+func (b *BeaconBlobDeriver) MinNodeVersions() map[services.Client]string {
+	return map[services.Client]string{
+		services.ClientLighthouse: "v4.6.0",
+		services.ClientTeku:       "v24.1.0",
+		services.ClientPrysm:      "v5.0.0",
+		services.ClientNimbus:     "v24.1.0",
+		services.ClientLodestar:   "v1.14.0",
+	}
+}
+
 func (b *BeaconBlobDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
 	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
 }
 
+func (b *BeaconBlobDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
 func (b *BeaconBlobDeriver) Start(ctx context.Context) error {
 	if !b.cfg.Enabled {
 		b.log.Info("Beacon blob deriver disabled")
@@ -73,6 +107,15 @@ func (b *BeaconBlobDeriver) Start(ctx context.Context) error {
 
 	b.log.Info("Beacon blob deriver enabled")
 
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
 	// Start our main loop
 	go b.run(ctx)
 
@@ -80,10 +123,24 @@ func (b *BeaconBlobDeriver) Start(ctx context.Context) error {
 }
 
 func (b *BeaconBlobDeriver) Stop(ctx context.Context) error {
-	return nil
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (b *BeaconBlobDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxInterval = 3 * time.Minute
 
@@ -93,6 +150,8 @@ func (b *BeaconBlobDeriver) run(rctx context.Context) {
 		select {
 		case <-rctx.Done():
 			return
+		case <-b.stopCtx.Done():
+			return
 		default:
 			operation := func() error {
 				ctx, span := tracer.Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
@@ -110,8 +169,15 @@ func (b *BeaconBlobDeriver) run(rctx context.Context) {
 				}
 
 				// Get the next slot
-				location, _, err := b.iterator.Next(ctx)
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, _, err := b.iterator.Next(nextCtx)
 				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
 					span.SetStatus(codes.Error, err.Error())
 
 					return err
@@ -119,14 +185,20 @@ func (b *BeaconBlobDeriver) run(rctx context.Context) {
 
 				// Process the epoch
 				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV1BeaconBlobSidecar().GetEpoch()))
+				b.breaker.Record(err)
+
 				if err != nil {
-					b.log.WithError(err).Error("Failed to process epoch")
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
 
 					span.SetStatus(codes.Error, err.Error())
 
 					return err
 				}
 
+				events = b.iterator.FilterSlotRange(events)
+
 				// Send the events
 				for _, fn := range b.onEventsCallbacks {
 					if err := fn(ctx, events); err != nil {
@@ -143,12 +215,24 @@ func (b *BeaconBlobDeriver) run(rctx context.Context) {
 					return err
 				}
 
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV1BeaconBlobSidecar().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
 				bo.Reset()
 
 				return nil
 			}
 
-			if err := backoff.Retry(operation, bo); err != nil {
+			if err := backoff.Retry(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx)); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
 				b.log.WithError(err).Error("Failed to process location")
 			}
 		}
@@ -162,6 +246,12 @@ func (b *BeaconBlobDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch
 	)
 	defer span.End()
 
+	// Blob sidecars were introduced in Deneb so there is nothing to derive from earlier epochs.
+	deneb, err := b.beacon.Metadata().Spec.ForkEpochs.GetByName("DENEB")
+	if err != nil || epoch < deneb.Epoch {
+		return []*xatu.DecoratedEvent{}, nil
+	}
+
 	sp, err := b.beacon.Node().Spec()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain spec")
@@ -172,7 +262,9 @@ func (b *BeaconBlobDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch
 	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
 		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
 
-		events, err := b.processSlot(ctx, slot)
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
 		}
@@ -191,7 +283,10 @@ func (b *BeaconBlobDeriver) processSlot(ctx context.Context, slot phase0.Slot) (
 	defer span.End()
 
 	// Get the block
-	blobs, err := b.beacon.Node().FetchBeaconBlockBlobs(ctx, xatuethv1.SlotAsString(slot))
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	blobs, err := b.beacon.GetBlobSidecars(fetchCtx, xatuethv1.SlotAsString(slot))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
 	}
@@ -216,7 +311,7 @@ func (b *BeaconBlobDeriver) processSlot(ctx context.Context, slot phase0.Slot) (
 
 func (b *BeaconBlobDeriver) createEventFromBlob(ctx context.Context, blob *deneb.BlobSidecar) (*xatu.DecoratedEvent, error) {
 	// Make a clone of the metadata
-	metadata, ok := proto.Clone(b.clientMeta).(*xatu.ClientMeta)
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
 	if !ok {
 		return nil, errors.New("failed to clone client metadata")
 	}
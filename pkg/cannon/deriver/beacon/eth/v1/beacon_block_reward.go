@@ -0,0 +1,401 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	v2 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v2"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	BeaconBlockRewardDeriverName = xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD
+)
+
+type BeaconBlockRewardDeriverConfig struct {
+	Enabled        bool               `yaml:"enabled" default:"false"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
+}
+
+type BeaconBlockRewardDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *BeaconBlockRewardDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	rewardsSupported    bool
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+}
+
+func NewBeaconBlockRewardDeriver(log logrus.FieldLogger, config *BeaconBlockRewardDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *BeaconBlockRewardDeriver {
+	return &BeaconBlockRewardDeriver{
+		log:           log.WithField("module", "cannon/event/beacon/eth/v1/beacon_block_reward"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
+	}
+}
+
+func (b *BeaconBlockRewardDeriver) CannonType() xatu.CannonType {
+	return BeaconBlockRewardDeriverName
+}
+
+func (b *BeaconBlockRewardDeriver) Name() string {
+	return BeaconBlockRewardDeriverName.String()
+}
+
+func (b *BeaconBlockRewardDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *BeaconBlockRewardDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *BeaconBlockRewardDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Beacon block reward deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Beacon block reward deriver enabled")
+
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *BeaconBlockRewardDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *BeaconBlockRewardDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		default:
+			operation := func() error {
+				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+					trace.WithAttributes(
+						attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+				)
+				defer span.End()
+
+				time.Sleep(100 * time.Millisecond)
+
+				if err := b.beacon.Synced(ctx); err != nil {
+					return err
+				}
+
+				if err := b.checkRewardsSupported(ctx); err != nil {
+					if errors.Is(err, ethereum.ErrBlockRewardsUnsupported) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Get the next slot
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
+				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Look ahead
+				b.lookAheadAtLocation(ctx, lookAhead)
+
+				// Process the epoch
+				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV1BeaconBlockReward().GetEpoch()))
+				b.breaker.Record(err)
+
+				if err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
+
+					if errors.Is(err, ethereum.ErrBlockRewardsUnsupported) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				events = b.iterator.FilterSlotRange(events)
+
+				for _, fn := range b.onEventsCallbacks {
+					if errr := fn(ctx, events); errr != nil {
+						return errors.Wrapf(errr, "failed to send events")
+					}
+				}
+
+				// Update our location
+				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
+					return err
+				}
+
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV1BeaconBlockReward().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
+				bo.Reset()
+
+				return nil
+			}
+
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
+				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
+				if errors.Is(err, ethereum.ErrBlockRewardsUnsupported) {
+					b.log.WithError(err).Error("Beacon node does not expose block rewards, stopping deriver. Use a beacon node that supports the rewards API or disable this deriver")
+
+					return
+				}
+
+				b.log.WithError(err).Warn("Failed to process")
+			}
+		}
+	}
+}
+
+func (b *BeaconBlockRewardDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"BeaconBlockRewardDeriver.processEpoch",
+		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
+	)
+	defer span.End()
+
+	sp, err := b.beacon.Node().Spec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	allEvents := []*xatu.DecoratedEvent{}
+
+	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
+		}
+
+		allEvents = append(allEvents, events...)
+	}
+
+	return allEvents, nil
+}
+
+// checkRewardsSupported confirms the beacon node exposes the block rewards endpoint by requesting the rewards of
+// the head block, which always exists. Missing rewards for a historical slot are otherwise indistinguishable from
+// a missing endpoint.
+func (b *BeaconBlockRewardDeriver) checkRewardsSupported(ctx context.Context) error {
+	if b.rewardsSupported {
+		return nil
+	}
+
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	if _, err := b.beacon.GetBlockRewards(fetchCtx, "head"); err != nil {
+		if errors.Is(err, ethereum.ErrBlockRewardsNotFound) {
+			return ethereum.ErrBlockRewardsUnsupported
+		}
+
+		return errors.Wrap(err, "failed to check block rewards support")
+	}
+
+	b.rewardsSupported = true
+
+	return nil
+}
+
+func (b *BeaconBlockRewardDeriver) processSlot(ctx context.Context, slot phase0.Slot) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"BeaconBlockRewardDeriver.processSlot",
+		trace.WithAttributes(attribute.Int64("slot", int64(slot))),
+	)
+	defer span.End()
+
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	// Get the block first so missed slots are skipped rather than treated as missing rewards
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
+	if err != nil {
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
+	}
+
+	blockIdentifier, err := v2.GetBlockIdentifier(block, b.beacon.Metadata().Wallclock())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get block identifier for slot %d", slot)
+	}
+
+	rewards, err := b.beacon.GetBlockRewards(fetchCtx, xatuethv1.SlotAsString(slot))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get block rewards for slot %d", slot)
+	}
+
+	event, err := b.createEvent(ctx, rewards, blockIdentifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create event for block reward in slot %d", slot)
+	}
+
+	return []*xatu.DecoratedEvent{event}, nil
+}
+
+// lookAheadAtLocation takes the upcoming locations and looks ahead to do any pre-processing that might be required.
+func (b *BeaconBlockRewardDeriver) lookAheadAtLocation(ctx context.Context, locations []*xatu.CannonLocation) {
+	_, span := observability.Tracer().Start(ctx,
+		"BeaconBlockRewardDeriver.lookAheadAtLocations",
+	)
+	defer span.End()
+
+	if locations == nil {
+		return
+	}
+
+	for _, location := range locations {
+		// Get the next look ahead epoch
+		epoch := phase0.Epoch(location.GetEthV1BeaconBlockReward().GetEpoch())
+
+		sp, err := b.beacon.Node().Spec()
+		if err != nil {
+			b.log.WithError(err).WithField("epoch", epoch).Warn("Failed to look ahead at epoch")
+
+			return
+		}
+
+		for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+			slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+			// Add the block to the preload queue so it's available when we need it
+			b.beacon.LazyLoadBeaconBlock(xatuethv1.SlotAsString(slot))
+		}
+	}
+}
+
+func (b *BeaconBlockRewardDeriver) createEvent(ctx context.Context, rewards *ethereum.BlockRewards, identifier *xatu.BlockIdentifier) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV1BeaconBlockReward{
+			EthV1BeaconBlockReward: &xatuethv1.BlockReward{
+				ProposerIndex:     &wrapperspb.UInt64Value{Value: rewards.ProposerIndex},
+				Total:             &wrapperspb.UInt64Value{Value: rewards.Total},
+				Attestations:      &wrapperspb.UInt64Value{Value: rewards.Attestations},
+				SyncAggregate:     &wrapperspb.UInt64Value{Value: rewards.SyncAggregate},
+				ProposerSlashings: &wrapperspb.UInt64Value{Value: rewards.ProposerSlashings},
+				AttesterSlashings: &wrapperspb.UInt64Value{Value: rewards.AttesterSlashings},
+			},
+		},
+	}
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV1BeaconBlockReward{
+		EthV1BeaconBlockReward: &xatu.ClientMeta_AdditionalEthV1BeaconBlockRewardData{
+			Block: identifier,
+		},
+	}
+
+	return decoratedEvent, nil
+}
@@ -0,0 +1,313 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	BeaconCommitteeDeriverName = xatu.CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE
+)
+
+type BeaconCommitteeDeriverConfig struct {
+	Enabled        bool               `yaml:"enabled" default:"false"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
+}
+
+// BeaconCommitteeDeriver emits the composition of every beacon committee of each finalized epoch. The events
+// match those the sentry emits from the head of the chain.
+type BeaconCommitteeDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *BeaconCommitteeDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+}
+
+func NewBeaconCommitteeDeriver(log logrus.FieldLogger, config *BeaconCommitteeDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, clientMeta func() *xatu.ClientMeta) *BeaconCommitteeDeriver {
+	return &BeaconCommitteeDeriver{
+		log:        log.WithField("module", "cannon/event/beacon/eth/v1/beacon_committee"),
+		cfg:        config,
+		iterator:   iter,
+		beacon:     beacon,
+		breaker:    breaker,
+		clientMeta: clientMeta,
+	}
+}
+
+func (b *BeaconCommitteeDeriver) CannonType() xatu.CannonType {
+	return BeaconCommitteeDeriverName
+}
+
+func (b *BeaconCommitteeDeriver) Name() string {
+	return BeaconCommitteeDeriverName.String()
+}
+
+func (b *BeaconCommitteeDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *BeaconCommitteeDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *BeaconCommitteeDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Beacon committee deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Beacon committee deriver enabled")
+
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *BeaconCommitteeDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *BeaconCommitteeDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		default:
+			operation := func() error {
+				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+					trace.WithAttributes(
+						attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+				)
+				defer span.End()
+
+				time.Sleep(100 * time.Millisecond)
+
+				if err := b.beacon.Synced(ctx); err != nil {
+					return err
+				}
+
+				// Get the next epoch
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, _, err := b.iterator.Next(nextCtx)
+				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Process the epoch
+				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV1BeaconCommittee().GetEpoch()))
+				b.breaker.Record(err)
+
+				if err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
+
+					return err
+				}
+
+				events = b.iterator.FilterSlotRange(events)
+
+				for _, fn := range b.onEventsCallbacks {
+					if errr := fn(ctx, events); errr != nil {
+						return errors.Wrapf(errr, "failed to send events")
+					}
+				}
+
+				// Update our location
+				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
+					return err
+				}
+
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV1BeaconCommittee().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
+				bo.Reset()
+
+				return nil
+			}
+
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
+				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
+				b.log.WithError(err).Warn("Failed to process")
+			}
+		}
+	}
+}
+
+func (b *BeaconCommitteeDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"BeaconCommitteeDeriver.processEpoch",
+		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
+	)
+	defer span.End()
+
+	sp, err := b.beacon.Node().Spec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	committees, err := b.fetchCommittees(ctx, epoch, phase0.Slot(uint64(epoch)*uint64(sp.SlotsPerEpoch)))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*xatu.DecoratedEvent, 0, len(committees))
+
+	for _, committee := range committees {
+		if committee == nil {
+			continue
+		}
+
+		event, err := b.createEvent(ctx, epoch, committee)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create event for committee %d in slot %d", committee.Index, committee.Slot)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (b *BeaconCommitteeDeriver) fetchCommittees(ctx context.Context, epoch phase0.Epoch, stateSlot phase0.Slot) ([]*apiv1.BeaconCommittee, error) {
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	committees, err := b.beacon.Node().FetchBeaconCommittees(fetchCtx, xatuethv1.SlotAsString(stateSlot), epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch beacon committees for epoch %d", epoch)
+	}
+
+	return committees, nil
+}
+
+func (b *BeaconCommitteeDeriver) createEvent(ctx context.Context, epoch phase0.Epoch, committee *apiv1.BeaconCommittee) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	validators := make([]*wrapperspb.UInt64Value, len(committee.Validators))
+	for i, index := range committee.Validators {
+		validators[i] = &wrapperspb.UInt64Value{Value: uint64(index)}
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V1_BEACON_COMMITTEE,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV1BeaconCommittee{
+			EthV1BeaconCommittee: &xatuethv1.Committee{
+				Slot:       &wrapperspb.UInt64Value{Value: uint64(committee.Slot)},
+				Index:      &wrapperspb.UInt64Value{Value: uint64(committee.Index)},
+				Validators: validators,
+			},
+		},
+	}
+
+	wallclockEpoch := b.beacon.Metadata().Wallclock().Epochs().FromNumber(uint64(epoch))
+	wallclockSlot := b.beacon.Metadata().Wallclock().Slots().FromNumber(uint64(committee.Slot))
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV1BeaconCommittee{
+		EthV1BeaconCommittee: &xatu.ClientMeta_AdditionalEthV1BeaconCommitteeData{
+			Epoch: &xatu.EpochV2{
+				Number:        &wrapperspb.UInt64Value{Value: wallclockEpoch.Number()},
+				StartDateTime: timestamppb.New(wallclockEpoch.TimeWindow().Start()),
+			},
+			Slot: &xatu.SlotV2{
+				Number:        &wrapperspb.UInt64Value{Value: uint64(committee.Slot)},
+				StartDateTime: timestamppb.New(wallclockSlot.TimeWindow().Start()),
+			},
+		},
+	}
+
+	return decoratedEvent, nil
+}
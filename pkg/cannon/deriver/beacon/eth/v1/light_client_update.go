@@ -0,0 +1,326 @@
+package v1
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"strings"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	LightClientUpdateDeriverName = xatu.CannonType_BEACON_API_ETH_V1_BEACON_LIGHT_CLIENT_UPDATE
+)
+
+type LightClientUpdateDeriverConfig struct {
+	Enabled        bool          `yaml:"enabled" default:"false"`
+	RequestTimeout time.Duration `yaml:"requestTimeout"`
+	// PollInterval is how often the beacon node is asked for its latest light client updates.
+	PollInterval time.Duration     `yaml:"pollInterval" default:"12s"`
+	Batch        eventbatch.Config `yaml:",inline"`
+	Dedup        dedup.Config      `yaml:"dedup"`
+}
+
+func (c *LightClientUpdateDeriverConfig) Validate() error {
+	if c.Enabled && c.PollInterval <= 0 {
+		return errors.New("pollInterval must be greater than 0")
+	}
+
+	return nil
+}
+
+// LightClientUpdateDeriver polls the beacon node for its latest light client finality and optimistic updates and
+// emits each new one. Light client updates only describe the head of the chain, so unlike the other derivers
+// there is no location to resume from and nothing is backfilled.
+type LightClientUpdateDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *LightClientUpdateDeriverConfig
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	clientMeta          func() *xatu.ClientMeta
+	// lastEmitted is the key of the last update emitted per type, so an update is only emitted once.
+	lastEmitted map[ethereum.LightClientUpdateType]string
+	stop        context.CancelFunc
+	stopCtx     context.Context
+	done        chan struct{}
+}
+
+func NewLightClientUpdateDeriver(log logrus.FieldLogger, config *LightClientUpdateDeriverConfig, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, clientMeta func() *xatu.ClientMeta) *LightClientUpdateDeriver {
+	return &LightClientUpdateDeriver{
+		log:         log.WithField("module", "cannon/event/beacon/eth/v1/light_client_update"),
+		cfg:         config,
+		beacon:      beacon,
+		breaker:     breaker,
+		clientMeta:  clientMeta,
+		lastEmitted: make(map[ethereum.LightClientUpdateType]string),
+	}
+}
+
+func (b *LightClientUpdateDeriver) CannonType() xatu.CannonType {
+	return LightClientUpdateDeriverName
+}
+
+func (b *LightClientUpdateDeriver) Name() string {
+	return LightClientUpdateDeriverName.String()
+}
+
+func (b *LightClientUpdateDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *LightClientUpdateDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *LightClientUpdateDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Light client update deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Light client update deriver enabled")
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *LightClientUpdateDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *LightClientUpdateDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	// Poll straight away on start up.
+	wait := time.Duration(0)
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		wait = b.cfg.PollInterval
+
+		operation := func() error {
+			ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+				trace.WithAttributes(
+					attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+			)
+			defer span.End()
+
+			if err := b.beacon.Synced(ctx); err != nil {
+				return err
+			}
+
+			if err := b.poll(ctx); err != nil {
+				if errors.Is(err, ethereum.ErrLightClientUnsupported) {
+					return backoff.Permanent(err)
+				}
+
+				if !b.breaker.IsOpen() {
+					b.log.WithError(err).Error("Failed to poll light client updates")
+				}
+
+				return err
+			}
+
+			bo.Reset()
+
+			return nil
+		}
+
+		if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+			if b.breaker.IsOpen() {
+				return
+			}
+
+			b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+		}); err != nil {
+			if errors.Is(err, ethereum.ErrLightClientUnsupported) {
+				b.log.WithError(err).Error("Beacon node does not serve light client updates, stopping deriver. Enable the light client server on the beacon node (e.g. --light-client-server for Lighthouse) or disable the deriver")
+
+				return
+			}
+
+			b.log.WithError(err).Warn("Failed to process")
+		}
+	}
+}
+
+// poll fetches the latest finality and optimistic updates and emits any that haven't been emitted yet.
+func (b *LightClientUpdateDeriver) poll(ctx context.Context) error {
+	ctx, span := observability.Tracer().Start(ctx, "LightClientUpdateDeriver.poll")
+	defer span.End()
+
+	for _, updateType := range []ethereum.LightClientUpdateType{
+		ethereum.LightClientUpdateTypeFinality,
+		ethereum.LightClientUpdateTypeOptimistic,
+	} {
+		fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+
+		update, err := b.beacon.GetLightClientUpdate(fetchCtx, updateType)
+
+		cancel()
+
+		if errors.Is(err, ethereum.ErrLightClientUpdateNotFound) {
+			b.breaker.Record(nil)
+
+			continue
+		}
+
+		b.breaker.Record(err)
+
+		if err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%d/%d", update.AttestedHeader.Slot, update.SignatureSlot)
+		if b.lastEmitted[updateType] == key {
+			continue
+		}
+
+		event, err := b.createEvent(ctx, update)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create event for light client %s update", updateType)
+		}
+
+		for _, fn := range b.onEventsCallbacks {
+			if err := fn(ctx, []*xatu.DecoratedEvent{event}); err != nil {
+				return errors.Wrapf(err, "failed to send events")
+			}
+		}
+
+		b.lastEmitted[updateType] = key
+	}
+
+	return nil
+}
+
+func (b *LightClientUpdateDeriver) createEvent(ctx context.Context, update *ethereum.LightClientUpdate) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	data := &xatuethv1.LightClientUpdate{
+		Type:           string(update.Type),
+		AttestedHeader: lightClientHeaderToProto(&update.AttestedHeader),
+		SyncAggregate: &xatuethv1.SyncAggregate{
+			SyncCommitteeBits:      update.SyncCommitteeBits,
+			SyncCommitteeSignature: update.SyncCommitteeSignature,
+		},
+		SignatureSlot: &wrapperspb.UInt64Value{Value: update.SignatureSlot},
+	}
+
+	if update.FinalizedHeader != nil {
+		data.FinalizedHeader = lightClientHeaderToProto(update.FinalizedHeader)
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V1_BEACON_LIGHT_CLIENT_UPDATE,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV1BeaconLightClientUpdate{
+			EthV1BeaconLightClientUpdate: data,
+		},
+	}
+
+	participation, err := countSyncCommitteeBits(update.SyncCommitteeBits)
+	if err != nil {
+		return nil, err
+	}
+
+	wallclockSlot := b.beacon.Metadata().Wallclock().Slots().FromNumber(update.AttestedHeader.Slot)
+	wallclockEpoch := b.beacon.Metadata().Wallclock().Epochs().FromSlot(update.AttestedHeader.Slot)
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV1BeaconLightClientUpdate{
+		EthV1BeaconLightClientUpdate: &xatu.ClientMeta_AdditionalEthV1BeaconLightClientUpdateData{
+			Epoch: &xatu.EpochV2{
+				Number:        &wrapperspb.UInt64Value{Value: wallclockEpoch.Number()},
+				StartDateTime: timestamppb.New(wallclockEpoch.TimeWindow().Start()),
+			},
+			Slot: &xatu.SlotV2{
+				Number:        &wrapperspb.UInt64Value{Value: update.AttestedHeader.Slot},
+				StartDateTime: timestamppb.New(wallclockSlot.TimeWindow().Start()),
+			},
+			Participation: &wrapperspb.UInt64Value{Value: participation},
+		},
+	}
+
+	return decoratedEvent, nil
+}
+
+func lightClientHeaderToProto(header *ethereum.LightClientHeader) *xatuethv1.BeaconBlockHeader {
+	return &xatuethv1.BeaconBlockHeader{
+		Slot:          header.Slot,
+		ProposerIndex: header.ProposerIndex,
+		ParentRoot:    header.ParentRoot,
+		StateRoot:     header.StateRoot,
+		BodyRoot:      header.BodyRoot,
+	}
+}
+
+// countSyncCommitteeBits returns the number of sync committee members that signed, from the hex encoded bitvector.
+func countSyncCommitteeBits(syncCommitteeBits string) (uint64, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(syncCommitteeBits, "0x"))
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid sync_committee_bits")
+	}
+
+	count := 0
+	for _, b := range raw {
+		count += bits.OnesCount8(b)
+	}
+
+	return uint64(count), nil
+}
@@ -0,0 +1,407 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	ProposerDutyDeriverName = xatu.CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY
+)
+
+type ProposerDutyDeriverConfig struct {
+	Enabled        bool               `yaml:"enabled" default:"false"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
+}
+
+// ProposerDutyDeriver emits an event for every slot of a finalized epoch, including skipped slots, with the
+// validator that was assigned to propose it and whether a canonical block was proposed.
+type ProposerDutyDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *ProposerDutyDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+}
+
+func NewProposerDutyDeriver(log logrus.FieldLogger, config *ProposerDutyDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, clientMeta func() *xatu.ClientMeta) *ProposerDutyDeriver {
+	return &ProposerDutyDeriver{
+		log:        log.WithField("module", "cannon/event/beacon/eth/v1/proposer_duty"),
+		cfg:        config,
+		iterator:   iter,
+		beacon:     beacon,
+		breaker:    breaker,
+		clientMeta: clientMeta,
+	}
+}
+
+func (b *ProposerDutyDeriver) CannonType() xatu.CannonType {
+	return ProposerDutyDeriverName
+}
+
+func (b *ProposerDutyDeriver) Name() string {
+	return ProposerDutyDeriverName.String()
+}
+
+func (b *ProposerDutyDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *ProposerDutyDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *ProposerDutyDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Proposer duty deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Proposer duty deriver enabled")
+
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *ProposerDutyDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *ProposerDutyDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		default:
+			operation := func() error {
+				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+					trace.WithAttributes(
+						attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+				)
+				defer span.End()
+
+				time.Sleep(100 * time.Millisecond)
+
+				if err := b.beacon.Synced(ctx); err != nil {
+					return err
+				}
+
+				// Get the next epoch
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
+				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Look ahead
+				b.lookAheadAtLocation(ctx, lookAhead)
+
+				// Process the epoch
+				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV1ProposerDuty().GetEpoch()))
+				b.breaker.Record(err)
+
+				if err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
+
+					return err
+				}
+
+				events = b.iterator.FilterSlotRange(events)
+
+				for _, fn := range b.onEventsCallbacks {
+					if errr := fn(ctx, events); errr != nil {
+						return errors.Wrapf(errr, "failed to send events")
+					}
+				}
+
+				// Update our location
+				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
+					return err
+				}
+
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV1ProposerDuty().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
+				bo.Reset()
+
+				return nil
+			}
+
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
+				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
+				b.log.WithError(err).Warn("Failed to process")
+			}
+		}
+	}
+}
+
+func (b *ProposerDutyDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"ProposerDutyDeriver.processEpoch",
+		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
+	)
+	defer span.End()
+
+	sp, err := b.beacon.Node().Spec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	duties, err := b.fetchProposerDuties(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	dutiesBySlot := make(map[phase0.Slot]*apiv1.ProposerDuty, len(duties))
+
+	for _, duty := range duties {
+		if duty == nil {
+			continue
+		}
+
+		dutiesBySlot[duty.Slot] = duty
+	}
+
+	events := make([]*xatu.DecoratedEvent, 0, sp.SlotsPerEpoch)
+
+	// Visit every slot in the epoch rather than only those with blocks so skipped slots are reported as missed.
+	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+		duty, ok := dutiesBySlot[slot]
+		if !ok {
+			b.log.WithField("slot", slot).Warn("Beacon node returned no proposer duty for slot")
+
+			continue
+		}
+
+		block, err := b.getBlock(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := b.createEvent(ctx, epoch, duty, block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create event for slot %d", slot)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (b *ProposerDutyDeriver) fetchProposerDuties(ctx context.Context, epoch phase0.Epoch) ([]*apiv1.ProposerDuty, error) {
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	duties, err := b.beacon.Node().FetchProposerDuties(fetchCtx, epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch proposer duties for epoch %d", epoch)
+	}
+
+	return duties, nil
+}
+
+// getBlock returns nil without an error when the slot was skipped.
+func (b *ProposerDutyDeriver) getBlock(ctx context.Context, slot phase0.Slot) (*spec.VersionedSignedBeaconBlock, error) {
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
+	if err != nil {
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
+	}
+
+	return block, nil
+}
+
+// lookAheadAtLocation takes the upcoming locations and looks ahead to do any pre-processing that might be required.
+func (b *ProposerDutyDeriver) lookAheadAtLocation(ctx context.Context, locations []*xatu.CannonLocation) {
+	_, span := observability.Tracer().Start(ctx,
+		"ProposerDutyDeriver.lookAheadAtLocations",
+	)
+	defer span.End()
+
+	if locations == nil {
+		return
+	}
+
+	for _, location := range locations {
+		// Get the next look ahead epoch
+		epoch := phase0.Epoch(location.GetEthV1ProposerDuty().GetEpoch())
+
+		sp, err := b.beacon.Node().Spec()
+		if err != nil {
+			b.log.WithError(err).WithField("epoch", epoch).Warn("Failed to look ahead at epoch")
+
+			return
+		}
+
+		for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+			slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+			// Add the block to the preload queue so it's available when we need it
+			b.beacon.LazyLoadBeaconBlock(xatuethv1.SlotAsString(slot))
+		}
+	}
+}
+
+func (b *ProposerDutyDeriver) createEvent(ctx context.Context, epoch phase0.Epoch, duty *apiv1.ProposerDuty, block *spec.VersionedSignedBeaconBlock) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	data := &xatuethv1.ProposerDuty{
+		Slot:           &wrapperspb.UInt64Value{Value: uint64(duty.Slot)},
+		ValidatorIndex: &wrapperspb.UInt64Value{Value: uint64(duty.ValidatorIndex)},
+		Pubkey:         duty.PubKey.String(),
+	}
+
+	if block != nil {
+		root, err := block.Root()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get block root")
+		}
+
+		proposerIndex, err := block.ProposerIndex()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get block proposer index")
+		}
+
+		if proposerIndex != duty.ValidatorIndex {
+			b.log.
+				WithField("slot", duty.Slot).
+				WithField("duty_validator_index", duty.ValidatorIndex).
+				WithField("block_proposer_index", proposerIndex).
+				Warn("Block proposer does not match the proposer duty")
+		}
+
+		// Only credit the assigned validator with the proposal if it actually made it.
+		data.Proposed = proposerIndex == duty.ValidatorIndex
+		data.BlockRoot = xatuethv1.RootAsString(root)
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V1_PROPOSER_DUTY,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV1ProposerDuty{
+			EthV1ProposerDuty: data,
+		},
+	}
+
+	wallclockEpoch := b.beacon.Metadata().Wallclock().Epochs().FromNumber(uint64(epoch))
+	wallclockSlot := b.beacon.Metadata().Wallclock().Slots().FromNumber(uint64(duty.Slot))
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV1ProposerDuty{
+		EthV1ProposerDuty: &xatu.ClientMeta_AdditionalEthV1ProposerDutyData{
+			Epoch: &xatu.EpochV2{
+				Number:        &wrapperspb.UInt64Value{Value: wallclockEpoch.Number()},
+				StartDateTime: timestamppb.New(wallclockEpoch.TimeWindow().Start()),
+			},
+			Slot: &xatu.SlotV2{
+				Number:        &wrapperspb.UInt64Value{Value: uint64(duty.Slot)},
+				StartDateTime: timestamppb.New(wallclockSlot.TimeWindow().Start()),
+			},
+		},
+	}
+
+	return decoratedEvent, nil
+}
@@ -0,0 +1,484 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	ValidatorActivityDeriverName = xatu.CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY
+)
+
+type ValidatorActivityDeriverConfig struct {
+	Enabled        bool               `yaml:"enabled" default:"false"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
+}
+
+// ValidatorActivityDeriver reports, per epoch, whether each validator's attestation duty was included on chain and
+// how well it voted. Attestations for an epoch can be included until the end of the following epoch, so every
+// epoch costs two epochs of blocks plus the committee assignments.
+type ValidatorActivityDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *ValidatorActivityDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+}
+
+func NewValidatorActivityDeriver(log logrus.FieldLogger, config *ValidatorActivityDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, clientMeta func() *xatu.ClientMeta) *ValidatorActivityDeriver {
+	return &ValidatorActivityDeriver{
+		log:        log.WithField("module", "cannon/event/beacon/eth/v1/validator_activity"),
+		cfg:        config,
+		iterator:   iter,
+		beacon:     beacon,
+		breaker:    breaker,
+		clientMeta: clientMeta,
+	}
+}
+
+func (b *ValidatorActivityDeriver) CannonType() xatu.CannonType {
+	return ValidatorActivityDeriverName
+}
+
+func (b *ValidatorActivityDeriver) Name() string {
+	return ValidatorActivityDeriverName.String()
+}
+
+func (b *ValidatorActivityDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *ValidatorActivityDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *ValidatorActivityDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Validator activity deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Validator activity deriver enabled")
+
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *ValidatorActivityDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *ValidatorActivityDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		default:
+			operation := func() error {
+				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+					trace.WithAttributes(
+						attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+				)
+				defer span.End()
+
+				time.Sleep(100 * time.Millisecond)
+
+				if err := b.beacon.Synced(ctx); err != nil {
+					return err
+				}
+
+				// Get the next epoch
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
+				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Look ahead
+				b.lookAheadAtLocation(ctx, lookAhead)
+
+				// Process the epoch
+				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV1BeaconValidatorActivity().GetEpoch()))
+				b.breaker.Record(err)
+
+				if err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
+
+					return err
+				}
+
+				events = b.iterator.FilterSlotRange(events)
+
+				for _, fn := range b.onEventsCallbacks {
+					if errr := fn(ctx, events); errr != nil {
+						return errors.Wrapf(errr, "failed to send events")
+					}
+				}
+
+				// Update our location
+				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
+					return err
+				}
+
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV1BeaconValidatorActivity().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
+				bo.Reset()
+
+				return nil
+			}
+
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
+				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
+				b.log.WithError(err).Warn("Failed to process")
+			}
+		}
+	}
+}
+
+type committeeKey struct {
+	slot  phase0.Slot
+	index phase0.CommitteeIndex
+}
+
+func (b *ValidatorActivityDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"ValidatorActivityDeriver.processEpoch",
+		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
+	)
+	defer span.End()
+
+	sp, err := b.beacon.Node().Spec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	slotsPerEpoch := uint64(sp.SlotsPerEpoch)
+	startSlot := phase0.Slot(uint64(epoch) * slotsPerEpoch)
+	endSlot := startSlot + phase0.Slot(slotsPerEpoch) - 1
+
+	committees, err := b.fetchCommittees(ctx, epoch, startSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	participation := make(map[committeeKey][]*xatuethv1.ValidatorParticipation, len(committees))
+
+	for _, committee := range committees {
+		entries := make([]*xatuethv1.ValidatorParticipation, len(committee.Validators))
+
+		for i, index := range committee.Validators {
+			entries[i] = &xatuethv1.ValidatorParticipation{
+				ValidatorIndex: &wrapperspb.UInt64Value{Value: uint64(index)},
+			}
+		}
+
+		participation[committeeKey{slot: committee.Slot, index: committee.Index}] = entries
+	}
+
+	// The target vote is correct when it matches the block root at the epoch boundary, which is the latest
+	// block at or before the first slot of the epoch.
+	targetRoot, err := b.rootAtOrBefore(ctx, startSlot, slotsPerEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get target root for epoch %d", epoch)
+	}
+
+	headRoots := make(map[phase0.Slot]phase0.Root, slotsPerEpoch)
+	latestRoot := targetRoot
+
+	// Attestations for this epoch may be included up to the end of the next epoch.
+	for slot := startSlot; slot <= endSlot+phase0.Slot(slotsPerEpoch); slot++ {
+		block, err := b.getBlock(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		if block != nil && slot <= endSlot {
+			root, err := block.Root()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get block root for slot %d", slot)
+			}
+
+			latestRoot = root
+		}
+
+		if slot <= endSlot {
+			headRoots[slot] = latestRoot
+		}
+
+		if block == nil {
+			continue
+		}
+
+		attestations, err := block.Attestations()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get attestations for slot %d", slot)
+		}
+
+		for _, attestation := range attestations {
+			if attestation == nil || attestation.Data == nil || attestation.Data.Target == nil {
+				continue
+			}
+
+			data := attestation.Data
+
+			if data.Slot < startSlot || data.Slot > endSlot || data.Slot >= slot {
+				continue
+			}
+
+			entries, ok := participation[committeeKey{slot: data.Slot, index: data.Index}]
+			if !ok {
+				b.log.
+					WithField("slot", data.Slot).
+					WithField("committee_index", data.Index).
+					Debug("Attestation references an unknown committee")
+
+				continue
+			}
+
+			delay := uint64(slot - data.Slot)
+
+			for i, entry := range entries {
+				if !attestation.AggregationBits.BitAt(uint64(i)) {
+					continue
+				}
+
+				// Keep the earliest inclusion of the validator's vote.
+				if entry.Included && entry.GetInclusionDelay().GetValue() <= delay {
+					continue
+				}
+
+				entry.Included = true
+				entry.InclusionSlot = &wrapperspb.UInt64Value{Value: uint64(slot)}
+				entry.InclusionDelay = &wrapperspb.UInt64Value{Value: delay}
+				entry.CorrectTarget = data.Target.Root == targetRoot
+				entry.CorrectHead = data.BeaconBlockRoot == headRoots[data.Slot]
+			}
+		}
+	}
+
+	events := make([]*xatu.DecoratedEvent, 0, len(committees))
+
+	for _, committee := range committees {
+		event, err := b.createEvent(ctx, epoch, committee, participation[committeeKey{slot: committee.Slot, index: committee.Index}])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create event for committee %d in slot %d", committee.Index, committee.Slot)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (b *ValidatorActivityDeriver) fetchCommittees(ctx context.Context, epoch phase0.Epoch, stateSlot phase0.Slot) ([]*apiv1.BeaconCommittee, error) {
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	committees, err := b.beacon.Node().FetchBeaconCommittees(fetchCtx, xatuethv1.SlotAsString(stateSlot), epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch beacon committees for epoch %d", epoch)
+	}
+
+	return committees, nil
+}
+
+// getBlock returns nil without an error when the slot was skipped.
+func (b *ValidatorActivityDeriver) getBlock(ctx context.Context, slot phase0.Slot) (*spec.VersionedSignedBeaconBlock, error) {
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
+	if err != nil {
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
+	}
+
+	return block, nil
+}
+
+// rootAtOrBefore walks back from the slot to the most recent block, giving up after maxSlots skipped slots.
+func (b *ValidatorActivityDeriver) rootAtOrBefore(ctx context.Context, slot phase0.Slot, maxSlots uint64) (phase0.Root, error) {
+	for i := uint64(0); i <= maxSlots; i++ {
+		block, err := b.getBlock(ctx, slot)
+		if err != nil {
+			return phase0.Root{}, err
+		}
+
+		if block != nil {
+			return block.Root()
+		}
+
+		if slot == 0 {
+			break
+		}
+
+		slot--
+	}
+
+	return phase0.Root{}, fmt.Errorf("no block found within %d slots", maxSlots)
+}
+
+// lookAheadAtLocation takes the upcoming locations and looks ahead to do any pre-processing that might be required.
+func (b *ValidatorActivityDeriver) lookAheadAtLocation(ctx context.Context, locations []*xatu.CannonLocation) {
+	_, span := observability.Tracer().Start(ctx,
+		"ValidatorActivityDeriver.lookAheadAtLocations",
+	)
+	defer span.End()
+
+	if locations == nil {
+		return
+	}
+
+	for _, location := range locations {
+		// Get the next look ahead epoch
+		epoch := phase0.Epoch(location.GetEthV1BeaconValidatorActivity().GetEpoch())
+
+		sp, err := b.beacon.Node().Spec()
+		if err != nil {
+			b.log.WithError(err).WithField("epoch", epoch).Warn("Failed to look ahead at epoch")
+
+			return
+		}
+
+		for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+			slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+			// Add the block to the preload queue so it's available when we need it
+			b.beacon.LazyLoadBeaconBlock(xatuethv1.SlotAsString(slot))
+		}
+	}
+}
+
+func (b *ValidatorActivityDeriver) createEvent(ctx context.Context, epoch phase0.Epoch, committee *apiv1.BeaconCommittee, validators []*xatuethv1.ValidatorParticipation) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV1BeaconValidatorActivity{
+			EthV1BeaconValidatorActivity: &xatuethv1.ValidatorActivity{
+				Epoch:          &wrapperspb.UInt64Value{Value: uint64(epoch)},
+				Slot:           &wrapperspb.UInt64Value{Value: uint64(committee.Slot)},
+				CommitteeIndex: &wrapperspb.UInt64Value{Value: uint64(committee.Index)},
+				Validators:     validators,
+			},
+		},
+	}
+
+	wallclockEpoch := b.beacon.Metadata().Wallclock().Epochs().FromNumber(uint64(epoch))
+	wallclockSlot := b.beacon.Metadata().Wallclock().Slots().FromNumber(uint64(committee.Slot))
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV1BeaconValidatorActivity{
+		EthV1BeaconValidatorActivity: &xatu.ClientMeta_AdditionalEthV1BeaconValidatorActivityData{
+			Epoch: &xatu.EpochV2{
+				Number:        &wrapperspb.UInt64Value{Value: wallclockEpoch.Number()},
+				StartDateTime: timestamppb.New(wallclockEpoch.TimeWindow().Start()),
+			},
+			Slot: &xatu.SlotV2{
+				Number:        &wrapperspb.UInt64Value{Value: uint64(committee.Slot)},
+				StartDateTime: timestamppb.New(wallclockSlot.TimeWindow().Start()),
+			},
+		},
+	}
+
+	return decoratedEvent, nil
+}
@@ -0,0 +1,383 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	AttestationDeriverName = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION
+)
+
+type AttestationDeriverConfig struct {
+	Enabled        bool               `yaml:"enabled" default:"false"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
+}
+
+type AttestationDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *AttestationDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+}
+
+func NewAttestationDeriver(log logrus.FieldLogger, config *AttestationDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *AttestationDeriver {
+	return &AttestationDeriver{
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/attestation"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
+	}
+}
+
+func (b *AttestationDeriver) CannonType() xatu.CannonType {
+	return AttestationDeriverName
+}
+
+func (b *AttestationDeriver) Name() string {
+	return AttestationDeriverName.String()
+}
+
+func (b *AttestationDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *AttestationDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *AttestationDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Attestation deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Attestation deriver enabled")
+
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *AttestationDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *AttestationDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		default:
+			operation := func() error {
+				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+					trace.WithAttributes(
+						attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+				)
+				defer span.End()
+
+				time.Sleep(100 * time.Millisecond)
+
+				if err := b.beacon.Synced(ctx); err != nil {
+					return err
+				}
+
+				// Get the next slot
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
+				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Look ahead
+				b.lookAheadAtLocation(ctx, lookAhead)
+
+				// Process the epoch
+				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockAttestation().GetEpoch()))
+				b.breaker.Record(err)
+
+				if err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
+
+					return err
+				}
+
+				events = b.iterator.FilterSlotRange(events)
+
+				for _, fn := range b.onEventsCallbacks {
+					if errr := fn(ctx, events); errr != nil {
+						return errors.Wrapf(errr, "failed to send events")
+					}
+				}
+
+				// Update our location
+				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
+					return err
+				}
+
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlockAttestation().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
+				bo.Reset()
+
+				return nil
+			}
+
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
+				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
+				b.log.WithError(err).Warn("Failed to process")
+			}
+		}
+	}
+}
+
+func (b *AttestationDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"AttestationDeriver.processEpoch",
+		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
+	)
+	defer span.End()
+
+	sp, err := b.beacon.Node().Spec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	allEvents := []*xatu.DecoratedEvent{}
+
+	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
+		}
+
+		allEvents = append(allEvents, events...)
+	}
+
+	return allEvents, nil
+}
+
+func (b *AttestationDeriver) processSlot(ctx context.Context, slot phase0.Slot) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"AttestationDeriver.processSlot",
+		trace.WithAttributes(attribute.Int64("slot", int64(slot))),
+	)
+	defer span.End()
+
+	// Get the block
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
+	if err != nil {
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
+	}
+
+	blockIdentifier, err := GetBlockIdentifier(block, b.beacon.Metadata().Wallclock())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get block identifier for slot %d", slot)
+	}
+
+	attestations, err := block.Attestations()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain attestations")
+	}
+
+	events := make([]*xatu.DecoratedEvent, 0, len(attestations))
+
+	for position, attestation := range attestations {
+		event, err := b.createEvent(ctx, attestation, uint64(position), slot, blockIdentifier)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create event for attestation %d in slot %d", position, slot)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// lookAheadAtLocation takes the upcoming locations and looks ahead to do any pre-processing that might be required.
+func (b *AttestationDeriver) lookAheadAtLocation(ctx context.Context, locations []*xatu.CannonLocation) {
+	_, span := observability.Tracer().Start(ctx,
+		"AttestationDeriver.lookAheadAtLocations",
+	)
+	defer span.End()
+
+	if locations == nil {
+		return
+	}
+
+	for _, location := range locations {
+		// Get the next look ahead epoch
+		epoch := phase0.Epoch(location.GetEthV2BeaconBlockAttestation().GetEpoch())
+
+		sp, err := b.beacon.Node().Spec()
+		if err != nil {
+			b.log.WithError(err).WithField("epoch", epoch).Warn("Failed to look ahead at epoch")
+
+			return
+		}
+
+		for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+			slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+			// Add the block to the preload queue so it's available when we need it
+			b.beacon.LazyLoadBeaconBlock(xatuethv1.SlotAsString(slot))
+		}
+	}
+}
+
+func (b *AttestationDeriver) createEvent(ctx context.Context, attestation *phase0.Attestation, position uint64, blockSlot phase0.Slot, identifier *xatu.BlockIdentifier) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	if attestation == nil || attestation.Data == nil {
+		return nil, errors.New("attestation is missing data")
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV2BeaconBlockAttestation{
+			EthV2BeaconBlockAttestation: &xatuethv1.AttestationV2{
+				AggregationBits: fmt.Sprintf("0x%x", []byte(attestation.AggregationBits)),
+				Signature:       attestation.Signature.String(),
+				Data: &xatuethv1.AttestationDataV2{
+					Slot:            &wrapperspb.UInt64Value{Value: uint64(attestation.Data.Slot)},
+					Index:           &wrapperspb.UInt64Value{Value: uint64(attestation.Data.Index)},
+					BeaconBlockRoot: attestation.Data.BeaconBlockRoot.String(),
+					Source: &xatuethv1.CheckpointV2{
+						Epoch: &wrapperspb.UInt64Value{Value: uint64(attestation.Data.Source.Epoch)},
+						Root:  attestation.Data.Source.Root.String(),
+					},
+					Target: &xatuethv1.CheckpointV2{
+						Epoch: &wrapperspb.UInt64Value{Value: uint64(attestation.Data.Target.Epoch)},
+						Root:  attestation.Data.Target.Root.String(),
+					},
+				},
+			},
+		},
+	}
+
+	additionalData := &xatu.ClientMeta_AdditionalEthV2BeaconBlockAttestationData{
+		Block:           identifier,
+		PositionInBlock: &wrapperspb.UInt64Value{Value: position},
+	}
+
+	if blockSlot >= attestation.Data.Slot {
+		additionalData.InclusionDelay = &wrapperspb.UInt64Value{Value: uint64(blockSlot - attestation.Data.Slot)}
+	}
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV2BeaconBlockAttestation{
+		EthV2BeaconBlockAttestation: additionalData,
+	}
+
+	return decoratedEvent, nil
+}
@@ -3,12 +3,17 @@ package v2
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
@@ -28,25 +33,49 @@ const (
 )
 
 type AttesterSlashingDeriverConfig struct {
-	Enabled bool `yaml:"enabled" default:"true"`
+	Enabled        bool               `yaml:"enabled" default:"true"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	// VerifySignatures drops slashings that do not verify against the beacon state.
+	VerifySignatures bool              `yaml:"verifySignatures" default:"false"`
+	Batch            eventbatch.Config `yaml:",inline"`
+	Dedup            dedup.Config      `yaml:"dedup"`
+}
+
+func (c *AttesterSlashingDeriverConfig) Validate() error {
+	if c.VerifySignatures && !ethereum.BLSAvailable {
+		return errors.New("verifySignatures requires xatu to be built with cgo")
+	}
+
+	return nil
 }
 
 type AttesterSlashingDeriver struct {
-	log               logrus.FieldLogger
-	cfg               *AttesterSlashingDeriverConfig
-	iterator          *iterator.CheckpointIterator
-	onEventsCallbacks []func(ctx context.Context, events []*xatu.DecoratedEvent) error
-	beacon            *ethereum.BeaconNode
-	clientMeta        *xatu.ClientMeta
+	log                 logrus.FieldLogger
+	cfg                 *AttesterSlashingDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
 }
 
-func NewAttesterSlashingDeriver(log logrus.FieldLogger, config *AttesterSlashingDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, clientMeta *xatu.ClientMeta) *AttesterSlashingDeriver {
+func NewAttesterSlashingDeriver(log logrus.FieldLogger, config *AttesterSlashingDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *AttesterSlashingDeriver {
 	return &AttesterSlashingDeriver{
-		log:        log.WithField("module", "cannon/event/beacon/eth/v2/attester_slashing"),
-		cfg:        config,
-		iterator:   iter,
-		beacon:     beacon,
-		clientMeta: clientMeta,
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/attester_slashing"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
 	}
 }
 
@@ -62,6 +91,10 @@ func (a *AttesterSlashingDeriver) OnEventsDerived(ctx context.Context, fn func(c
 	a.onEventsCallbacks = append(a.onEventsCallbacks, fn)
 }
 
+func (a *AttesterSlashingDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	a.onLocationCallbacks = append(a.onLocationCallbacks, fn)
+}
+
 func (a *AttesterSlashingDeriver) Start(ctx context.Context) error {
 	if !a.cfg.Enabled {
 		a.log.Info("Attester slashing deriver disabled")
@@ -71,6 +104,15 @@ func (a *AttesterSlashingDeriver) Start(ctx context.Context) error {
 
 	a.log.Info("Attester slashing deriver enabled")
 
+	if a.cfg.StartEpoch != nil {
+		a.iterator.SetStartEpoch(phase0.Epoch(*a.cfg.StartEpoch))
+	}
+
+	a.iterator.SetSlotRange(a.cfg.SlotRange)
+
+	a.stopCtx, a.stop = context.WithCancel(context.Background())
+	a.done = make(chan struct{})
+
 	// Start our main loop
 	go a.run(ctx)
 
@@ -78,10 +120,24 @@ func (a *AttesterSlashingDeriver) Start(ctx context.Context) error {
 }
 
 func (a *AttesterSlashingDeriver) Stop(ctx context.Context) error {
-	return nil
+	if a.stop == nil {
+		return nil
+	}
+
+	a.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (a *AttesterSlashingDeriver) run(rctx context.Context) {
+	defer close(a.done)
+
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxInterval = 3 * time.Minute
 
@@ -89,6 +145,8 @@ func (a *AttesterSlashingDeriver) run(rctx context.Context) {
 		select {
 		case <-rctx.Done():
 			return
+		case <-a.stopCtx.Done():
+			return
 		default:
 			operation := func() error {
 				ctx, span := observability.Tracer().Start(rctx,
@@ -105,8 +163,15 @@ func (a *AttesterSlashingDeriver) run(rctx context.Context) {
 				}
 
 				// Get the next slot
-				location, lookAhead, err := a.iterator.Next(ctx)
+				nextCtx, cancelNext := iterator.WithStop(ctx, a.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := a.iterator.Next(nextCtx)
 				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
 					return err
 				}
 
@@ -115,12 +180,18 @@ func (a *AttesterSlashingDeriver) run(rctx context.Context) {
 
 				// Process the epoch
 				events, err := a.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockAttesterSlashing().GetEpoch()))
+				a.breaker.Record(err)
+
 				if err != nil {
-					a.log.WithError(err).Error("Failed to process epoch")
+					if !a.breaker.IsOpen() {
+						a.log.WithError(err).Error("Failed to process epoch")
+					}
 
 					return err
 				}
 
+				events = a.iterator.FilterSlotRange(events)
+
 				// Send the events
 				for _, fn := range a.onEventsCallbacks {
 					if err := fn(ctx, events); err != nil {
@@ -133,14 +204,30 @@ func (a *AttesterSlashingDeriver) run(rctx context.Context) {
 					return err
 				}
 
+				for _, fn := range a.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlockAttesterSlashing().GetEpoch()); errr != nil {
+						a.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
 				bo.Reset()
 
 				return nil
 			}
 
-			if err := backoff.RetryNotify(operation, bo, func(err error, timer time.Duration) {
+			if err := backoff.RetryNotify(a.breaker.Wrap(a.stopCtx, operation), backoff.WithContext(bo, a.stopCtx), func(err error, timer time.Duration) {
+				if a.breaker.IsOpen() {
+					return
+				}
+
 				a.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
 			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					a.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
 				a.log.WithError(err).Warn("Failed to process")
 			}
 		}
@@ -195,7 +282,9 @@ func (a *AttesterSlashingDeriver) processEpoch(ctx context.Context, epoch phase0
 	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
 		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
 
-		events, err := a.processSlot(ctx, slot)
+		events, err := a.slotDeadlines.Process(ctx, a.CannonType(), &a.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return a.processSlot(ctx, slot)
+		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
 		}
@@ -214,13 +303,16 @@ func (a *AttesterSlashingDeriver) processSlot(ctx context.Context, slot phase0.S
 	defer span.End()
 
 	// Get the block
-	block, err := a.beacon.GetBeaconBlock(ctx, xatuethv1.SlotAsString(slot))
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, a.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := a.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
-	}
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
 
-	if block == nil {
-		return []*xatu.DecoratedEvent{}, nil
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
 	}
 
 	blockIdentifier, err := GetBlockIdentifier(block, a.beacon.Metadata().Wallclock())
@@ -258,6 +350,18 @@ func (a *AttesterSlashingDeriver) getAttesterSlashings(ctx context.Context, bloc
 	}
 
 	for _, slashing := range attesterSlashings {
+		if a.cfg.VerifySignatures {
+			if err := a.beacon.VerifyAttesterSlashing(ctx, slashing); err != nil {
+				if !errors.Is(err, ethereum.ErrInvalidSlashing) {
+					return nil, errors.Wrap(err, "failed to verify attester slashing")
+				}
+
+				a.log.WithError(err).Warn("Skipping invalid attester slashing")
+
+				continue
+			}
+		}
+
 		slashings = append(slashings, &xatuethv1.AttesterSlashingV2{
 			Attestation_1: convertIndexedAttestation(slashing.Attestation1),
 			Attestation_2: convertIndexedAttestation(slashing.Attestation2),
@@ -295,7 +399,7 @@ func convertIndexedAttestation(attestation *phase0.IndexedAttestation) *xatuethv
 
 func (a *AttesterSlashingDeriver) createEvent(ctx context.Context, slashing *xatuethv1.AttesterSlashingV2, identifier *xatu.BlockIdentifier) (*xatu.DecoratedEvent, error) {
 	// Make a clone of the metadata
-	metadata, ok := proto.Clone(a.clientMeta).(*xatu.ClientMeta)
+	metadata, ok := proto.Clone(a.clientMeta()).(*xatu.ClientMeta)
 	if !ok {
 		return nil, errors.New("failed to clone client metadata")
 	}
@@ -316,9 +420,42 @@ func (a *AttesterSlashingDeriver) createEvent(ctx context.Context, slashing *xat
 
 	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV2BeaconBlockAttesterSlashing{
 		EthV2BeaconBlockAttesterSlashing: &xatu.ClientMeta_AdditionalEthV2BeaconBlockAttesterSlashingData{
-			Block: identifier,
+			Block:                   identifier,
+			SlashedValidatorIndices: slashedValidatorIndices(slashing),
 		},
 	}
 
 	return decoratedEvent, nil
 }
+
+// slashedValidatorIndices returns the validators attesting in both attestations of the slashing, which are the
+// validators that get slashed.
+func slashedValidatorIndices(slashing *xatuethv1.AttesterSlashingV2) []*wrapperspb.UInt64Value {
+	attesting := make(map[uint64]struct{}, len(slashing.GetAttestation_1().GetAttestingIndices()))
+
+	for _, index := range slashing.GetAttestation_1().GetAttestingIndices() {
+		attesting[index.GetValue()] = struct{}{}
+	}
+
+	slashed := []uint64{}
+
+	for _, index := range slashing.GetAttestation_2().GetAttestingIndices() {
+		if _, ok := attesting[index.GetValue()]; !ok {
+			continue
+		}
+
+		// Guard against an index repeated in the second attestation.
+		delete(attesting, index.GetValue())
+
+		slashed = append(slashed, index.GetValue())
+	}
+
+	sort.Slice(slashed, func(i, j int) bool { return slashed[i] < slashed[j] })
+
+	indices := make([]*wrapperspb.UInt64Value, 0, len(slashed))
+	for _, index := range slashed {
+		indices = append(indices, &wrapperspb.UInt64Value{Value: index})
+	}
+
+	return indices
+}
@@ -0,0 +1,67 @@
+package v2
+
+import (
+	"testing"
+
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func indexedAttestation(indices ...uint64) *xatuethv1.IndexedAttestationV2 {
+	attesting := make([]*wrapperspb.UInt64Value, 0, len(indices))
+
+	for _, index := range indices {
+		attesting = append(attesting, wrapperspb.UInt64(index))
+	}
+
+	return &xatuethv1.IndexedAttestationV2{AttestingIndices: attesting}
+}
+
+func TestSlashedValidatorIndices(t *testing.T) {
+	tests := []struct {
+		name          string
+		attestation1  []uint64
+		attestation2  []uint64
+		expectedIndex []uint64
+	}{
+		{name: "disjoint", attestation1: []uint64{1, 2, 3}, attestation2: []uint64{4, 5, 6}, expectedIndex: []uint64{}},
+		{name: "identical", attestation1: []uint64{1, 2, 3}, attestation2: []uint64{1, 2, 3}, expectedIndex: []uint64{1, 2, 3}},
+		{name: "overlapping", attestation1: []uint64{1, 2, 3, 4}, attestation2: []uint64{3, 4, 5}, expectedIndex: []uint64{3, 4}},
+		{name: "duplicate in first", attestation1: []uint64{7, 7, 8}, attestation2: []uint64{7, 9}, expectedIndex: []uint64{7}},
+		{name: "duplicate in second", attestation1: []uint64{7, 8}, attestation2: []uint64{8, 8, 7, 7}, expectedIndex: []uint64{7, 8}},
+		{name: "unsorted", attestation1: []uint64{90, 10, 50, 30}, attestation2: []uint64{50, 90, 20, 10}, expectedIndex: []uint64{10, 50, 90}},
+		{name: "empty first", attestation1: nil, attestation2: []uint64{1, 2}, expectedIndex: []uint64{}},
+		{name: "empty second", attestation1: []uint64{1, 2}, attestation2: nil, expectedIndex: []uint64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashing := &xatuethv1.AttesterSlashingV2{
+				Attestation_1: indexedAttestation(tt.attestation1...),
+				Attestation_2: indexedAttestation(tt.attestation2...),
+			}
+
+			indices := slashedValidatorIndices(slashing)
+
+			got := make([]uint64, 0, len(indices))
+			for _, index := range indices {
+				got = append(got, index.GetValue())
+			}
+
+			assert.Equal(t, tt.expectedIndex, got)
+
+			// Swapping the attestations must not change the result or its order.
+			swapped := slashedValidatorIndices(&xatuethv1.AttesterSlashingV2{
+				Attestation_1: slashing.GetAttestation_2(),
+				Attestation_2: slashing.GetAttestation_1(),
+			})
+
+			assert.Equal(t, indices, swapped)
+		})
+	}
+}
+
+func TestSlashedValidatorIndicesNilAttestations(t *testing.T) {
+	assert.Empty(t, slashedValidatorIndices(&xatuethv1.AttesterSlashingV2{}))
+}
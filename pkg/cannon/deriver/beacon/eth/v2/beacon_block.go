@@ -9,7 +9,11 @@ import (
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	"github.com/ethpandaops/xatu/pkg/proto/eth"
@@ -33,25 +37,41 @@ const (
 )
 
 type BeaconBlockDeriverConfig struct {
-	Enabled bool `yaml:"enabled" default:"true"`
+	Enabled        bool               `yaml:"enabled" default:"true"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	// IncludeRawBlock attaches the SSZ encoded block to each event. Off by default as it considerably increases the event size.
+	IncludeRawBlock bool              `yaml:"includeRawBlock" default:"false"`
+	Batch           eventbatch.Config `yaml:",inline"`
+	Dedup           dedup.Config      `yaml:"dedup"`
 }
 
 type BeaconBlockDeriver struct {
-	log               logrus.FieldLogger
-	cfg               *BeaconBlockDeriverConfig
-	iterator          *iterator.CheckpointIterator
-	onEventsCallbacks []func(ctx context.Context, events []*xatu.DecoratedEvent) error
-	beacon            *ethereum.BeaconNode
-	clientMeta        *xatu.ClientMeta
+	log                 logrus.FieldLogger
+	cfg                 *BeaconBlockDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
 }
 
-func NewBeaconBlockDeriver(log logrus.FieldLogger, config *BeaconBlockDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, clientMeta *xatu.ClientMeta) *BeaconBlockDeriver {
+func NewBeaconBlockDeriver(log logrus.FieldLogger, config *BeaconBlockDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *BeaconBlockDeriver {
 	return &BeaconBlockDeriver{
-		log:        log.WithField("module", "cannon/event/beacon/eth/v2/beacon_block"),
-		cfg:        config,
-		iterator:   iter,
-		beacon:     beacon,
-		clientMeta: clientMeta,
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/beacon_block"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
 	}
 }
 
@@ -67,6 +87,10 @@ func (b *BeaconBlockDeriver) OnEventsDerived(ctx context.Context, fn func(ctx co
 	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
 }
 
+func (b *BeaconBlockDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
 func (b *BeaconBlockDeriver) Start(ctx context.Context) error {
 	if !b.cfg.Enabled {
 		b.log.Info("Beacon block deriver disabled")
@@ -76,6 +100,15 @@ func (b *BeaconBlockDeriver) Start(ctx context.Context) error {
 
 	b.log.Info("Beacon block deriver enabled")
 
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
 	// Start our main loop
 	go b.run(ctx)
 
@@ -83,10 +116,24 @@ func (b *BeaconBlockDeriver) Start(ctx context.Context) error {
 }
 
 func (b *BeaconBlockDeriver) Stop(ctx context.Context) error {
-	return nil
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (b *BeaconBlockDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxInterval = 3 * time.Minute
 
@@ -96,6 +143,8 @@ func (b *BeaconBlockDeriver) run(rctx context.Context) {
 		select {
 		case <-rctx.Done():
 			return
+		case <-b.stopCtx.Done():
+			return
 		default:
 			operation := func() error {
 				ctx, span := tracer.Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
@@ -117,8 +166,15 @@ func (b *BeaconBlockDeriver) run(rctx context.Context) {
 				span.AddEvent("Grabbing next location")
 
 				// Get the next slot
-				location, lookAhead, err := b.iterator.Next(ctx)
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
 				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
 					span.SetStatus(codes.Error, err.Error())
 
 					return err
@@ -133,14 +189,20 @@ func (b *BeaconBlockDeriver) run(rctx context.Context) {
 
 				// Process the epoch
 				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlock().GetEpoch()))
+				b.breaker.Record(err)
+
 				if err != nil {
-					b.log.WithError(err).Error("Failed to process epoch")
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
 
 					span.SetStatus(codes.Error, err.Error())
 
 					return err
 				}
 
+				events = b.iterator.FilterSlotRange(events)
+
 				span.AddEvent("Epoch processing complete. Sending events...")
 
 				// Send the events
@@ -161,6 +223,12 @@ func (b *BeaconBlockDeriver) run(rctx context.Context) {
 					return err
 				}
 
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlock().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
 				span.AddEvent("Location updated. Done.")
 
 				bo.Reset()
@@ -168,7 +236,13 @@ func (b *BeaconBlockDeriver) run(rctx context.Context) {
 				return nil
 			}
 
-			if err := backoff.Retry(operation, bo); err != nil {
+			if err := backoff.Retry(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx)); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
 				b.log.WithError(err).Error("Failed to process location")
 			}
 		}
@@ -223,7 +297,9 @@ func (b *BeaconBlockDeriver) processEpoch(ctx context.Context, epoch phase0.Epoc
 	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
 		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
 
-		events, err := b.processSlot(ctx, slot)
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
 		}
@@ -242,13 +318,16 @@ func (b *BeaconBlockDeriver) processSlot(ctx context.Context, slot phase0.Slot)
 	defer span.End()
 
 	// Get the block
-	block, err := b.beacon.GetBeaconBlock(ctx, xatuethv1.SlotAsString(slot))
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
-	}
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
 
-	if block == nil {
-		return []*xatu.DecoratedEvent{}, nil
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
 	}
 
 	event, err := b.createEventFromBlock(ctx, block)
@@ -261,7 +340,7 @@ func (b *BeaconBlockDeriver) processSlot(ctx context.Context, slot phase0.Slot)
 
 func (b *BeaconBlockDeriver) createEventFromBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) (*xatu.DecoratedEvent, error) {
 	// Make a clone of the metadata
-	metadata, ok := proto.Clone(b.clientMeta).(*xatu.ClientMeta)
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
 	if !ok {
 		return nil, errors.New("failed to clone client metadata")
 	}
@@ -390,5 +469,31 @@ func (b *BeaconBlockDeriver) getAdditionalData(_ context.Context, block *spec.Ve
 	// Always set to true when derived from the cannon.
 	extra.FinalizedWhenRequested = true
 
+	if b.cfg.IncludeRawBlock {
+		raw, err := marshalBlockSSZ(block)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to ssz encode block")
+		}
+
+		extra.RawBlock = fmt.Sprintf("%#x", raw)
+	}
+
 	return extra, nil
 }
+
+func marshalBlockSSZ(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		return block.Phase0.MarshalSSZ()
+	case spec.DataVersionAltair:
+		return block.Altair.MarshalSSZ()
+	case spec.DataVersionBellatrix:
+		return block.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		return block.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		return block.Deneb.MarshalSSZ()
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", block.Version)
+	}
+}
@@ -0,0 +1,414 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	xatuethv2 "github.com/ethpandaops/xatu/pkg/proto/eth/v2"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	BlockSummaryDeriverName = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY
+)
+
+type BlockSummaryDeriverConfig struct {
+	Enabled        bool               `yaml:"enabled" default:"true"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
+}
+
+type BlockSummaryDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *BlockSummaryDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+}
+
+func NewBlockSummaryDeriver(log logrus.FieldLogger, config *BlockSummaryDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *BlockSummaryDeriver {
+	return &BlockSummaryDeriver{
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/block_summary"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
+	}
+}
+
+func (b *BlockSummaryDeriver) CannonType() xatu.CannonType {
+	return BlockSummaryDeriverName
+}
+
+func (b *BlockSummaryDeriver) Name() string {
+	return BlockSummaryDeriverName.String()
+}
+
+func (b *BlockSummaryDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *BlockSummaryDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *BlockSummaryDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Block summary deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Block summary deriver enabled")
+
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *BlockSummaryDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *BlockSummaryDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		default:
+			operation := func() error {
+				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+					trace.WithAttributes(
+						attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+				)
+				defer span.End()
+
+				time.Sleep(100 * time.Millisecond)
+
+				if err := b.beacon.Synced(ctx); err != nil {
+					return err
+				}
+
+				// Get the next slot
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
+				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Look ahead
+				b.lookAheadAtLocation(ctx, lookAhead)
+
+				// Process the epoch
+				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockSummary().GetEpoch()))
+				b.breaker.Record(err)
+
+				if err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
+
+					return err
+				}
+
+				events = b.iterator.FilterSlotRange(events)
+
+				for _, fn := range b.onEventsCallbacks {
+					if errr := fn(ctx, events); errr != nil {
+						return errors.Wrapf(errr, "failed to send events")
+					}
+				}
+
+				// Update our location
+				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
+					return err
+				}
+
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlockSummary().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
+				bo.Reset()
+
+				return nil
+			}
+
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
+				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
+				b.log.WithError(err).Warn("Failed to process")
+			}
+		}
+	}
+}
+
+func (b *BlockSummaryDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"BlockSummaryDeriver.processEpoch",
+		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
+	)
+	defer span.End()
+
+	sp, err := b.beacon.Node().Spec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	allEvents := []*xatu.DecoratedEvent{}
+
+	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
+		}
+
+		allEvents = append(allEvents, events...)
+	}
+
+	return allEvents, nil
+}
+
+func (b *BlockSummaryDeriver) processSlot(ctx context.Context, slot phase0.Slot) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"BlockSummaryDeriver.processSlot",
+		trace.WithAttributes(attribute.Int64("slot", int64(slot))),
+	)
+	defer span.End()
+
+	// Get the block
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
+	if err != nil {
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
+	}
+
+	blockIdentifier, err := GetBlockIdentifier(block, b.beacon.Metadata().Wallclock())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get block identifier for slot %d", slot)
+	}
+
+	summary, err := b.getBlockSummary(ctx, block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block summary")
+	}
+
+	event, err := b.createEvent(ctx, summary, blockIdentifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create event for block summary in slot %d", slot)
+	}
+
+	return []*xatu.DecoratedEvent{event}, nil
+}
+
+// lookAheadAtLocation takes the upcoming locations and looks ahead to do any pre-processing that might be required.
+func (b *BlockSummaryDeriver) lookAheadAtLocation(ctx context.Context, locations []*xatu.CannonLocation) {
+	_, span := observability.Tracer().Start(ctx,
+		"BlockSummaryDeriver.lookAheadAtLocations",
+	)
+	defer span.End()
+
+	if locations == nil {
+		return
+	}
+
+	for _, location := range locations {
+		// Get the next look ahead epoch
+		epoch := phase0.Epoch(location.GetEthV2BeaconBlockSummary().GetEpoch())
+
+		sp, err := b.beacon.Node().Spec()
+		if err != nil {
+			b.log.WithError(err).WithField("epoch", epoch).Warn("Failed to look ahead at epoch")
+
+			return
+		}
+
+		for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+			slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+			// Add the block to the preload queue so it's available when we need it
+			b.beacon.LazyLoadBeaconBlock(xatuethv1.SlotAsString(slot))
+		}
+	}
+}
+
+func (b *BlockSummaryDeriver) getBlockSummary(ctx context.Context, block *spec.VersionedSignedBeaconBlock) (*xatuethv2.BlockSummary, error) {
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot")
+	}
+
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposer index")
+	}
+
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain parent root")
+	}
+
+	stateRoot, err := block.StateRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state root")
+	}
+
+	graffiti, err := block.Graffiti()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain graffiti")
+	}
+
+	attestations, err := block.Attestations()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain attestations")
+	}
+
+	deposits, err := block.Deposits()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain deposits")
+	}
+
+	transactionsCount := 0
+
+	// Execution payloads were introduced in Bellatrix so earlier blocks have no transactions.
+	switch block.Version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair:
+	default:
+		transactions, err := block.ExecutionTransactions()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain execution transactions")
+		}
+
+		transactionsCount = len(transactions)
+	}
+
+	return &xatuethv2.BlockSummary{
+		Slot:              &wrapperspb.UInt64Value{Value: uint64(slot)},
+		ProposerIndex:     &wrapperspb.UInt64Value{Value: uint64(proposerIndex)},
+		ParentRoot:        xatuethv1.RootAsString(parentRoot),
+		StateRoot:         xatuethv1.RootAsString(stateRoot),
+		Graffiti:          fmt.Sprintf("0x%x", graffiti[:]),
+		AttestationsCount: &wrapperspb.UInt64Value{Value: uint64(len(attestations))},
+		DepositsCount:     &wrapperspb.UInt64Value{Value: uint64(len(deposits))},
+		TransactionsCount: &wrapperspb.UInt64Value{Value: uint64(transactionsCount)},
+	}, nil
+}
+
+func (b *BlockSummaryDeriver) createEvent(ctx context.Context, summary *xatuethv2.BlockSummary, identifier *xatu.BlockIdentifier) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV2BeaconBlockSummary{
+			EthV2BeaconBlockSummary: summary,
+		},
+	}
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV2BeaconBlockSummary{
+		EthV2BeaconBlockSummary: &xatu.ClientMeta_AdditionalEthV2BeaconBlockSummaryData{
+			Block: identifier,
+		},
+	}
+
+	return decoratedEvent, nil
+}
@@ -2,13 +2,19 @@ package v2
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
@@ -30,25 +36,41 @@ const (
 )
 
 type BLSToExecutionChangeDeriverConfig struct {
-	Enabled bool `yaml:"enabled" default:"true"`
+	Enabled        bool               `yaml:"enabled" default:"true"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	// ResolveWithdrawalCredentials attaches the 0x01 withdrawal credentials the change results in to each event.
+	ResolveWithdrawalCredentials bool              `yaml:"resolveWithdrawalCredentials" default:"false"`
+	Batch                        eventbatch.Config `yaml:",inline"`
+	Dedup                        dedup.Config      `yaml:"dedup"`
 }
 
 type BLSToExecutionChangeDeriver struct {
-	log               logrus.FieldLogger
-	cfg               *BLSToExecutionChangeDeriverConfig
-	iterator          *iterator.CheckpointIterator
-	onEventsCallbacks []func(ctx context.Context, events []*xatu.DecoratedEvent) error
-	beacon            *ethereum.BeaconNode
-	clientMeta        *xatu.ClientMeta
+	log                 logrus.FieldLogger
+	cfg                 *BLSToExecutionChangeDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
 }
 
-func NewBLSToExecutionChangeDeriver(log logrus.FieldLogger, config *BLSToExecutionChangeDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, clientMeta *xatu.ClientMeta) *BLSToExecutionChangeDeriver {
+func NewBLSToExecutionChangeDeriver(log logrus.FieldLogger, config *BLSToExecutionChangeDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *BLSToExecutionChangeDeriver {
 	return &BLSToExecutionChangeDeriver{
-		log:        log.WithField("module", "cannon/event/beacon/eth/v2/bls_to_execution_change"),
-		cfg:        config,
-		iterator:   iter,
-		beacon:     beacon,
-		clientMeta: clientMeta,
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/bls_to_execution_change"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
 	}
 }
 
@@ -64,6 +86,10 @@ func (b *BLSToExecutionChangeDeriver) OnEventsDerived(ctx context.Context, fn fu
 	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
 }
 
+func (b *BLSToExecutionChangeDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
 func (b *BLSToExecutionChangeDeriver) Start(ctx context.Context) error {
 	if !b.cfg.Enabled {
 		b.log.Info("BLS to execution change deriver disabled")
@@ -73,6 +99,15 @@ func (b *BLSToExecutionChangeDeriver) Start(ctx context.Context) error {
 
 	b.log.Info("BLS to execution change deriver enabled")
 
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
 	// Start our main loop
 	go b.run(ctx)
 
@@ -80,10 +115,24 @@ func (b *BLSToExecutionChangeDeriver) Start(ctx context.Context) error {
 }
 
 func (b *BLSToExecutionChangeDeriver) Stop(ctx context.Context) error {
-	return nil
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (b *BLSToExecutionChangeDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxInterval = 3 * time.Minute
 
@@ -91,6 +140,8 @@ func (b *BLSToExecutionChangeDeriver) run(rctx context.Context) {
 		select {
 		case <-rctx.Done():
 			return
+		case <-b.stopCtx.Done():
+			return
 		default:
 			operation := func() error {
 				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
@@ -106,8 +157,15 @@ func (b *BLSToExecutionChangeDeriver) run(rctx context.Context) {
 				}
 
 				// Get the next slot
-				location, lookAheads, err := b.iterator.Next(ctx)
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAheads, err := b.iterator.Next(nextCtx)
 				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
 					return err
 				}
 
@@ -116,12 +174,18 @@ func (b *BLSToExecutionChangeDeriver) run(rctx context.Context) {
 
 				// Process the epoch
 				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockBlsToExecutionChange().GetEpoch()))
+				b.breaker.Record(err)
+
 				if err != nil {
-					b.log.WithError(err).Error("Failed to process epoch")
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
 
 					return err
 				}
 
+				events = b.iterator.FilterSlotRange(events)
+
 				// Send the events
 				for _, fn := range b.onEventsCallbacks {
 					if err := fn(ctx, events); err != nil {
@@ -134,14 +198,30 @@ func (b *BLSToExecutionChangeDeriver) run(rctx context.Context) {
 					return err
 				}
 
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlockBlsToExecutionChange().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
 				bo.Reset()
 
 				return nil
 			}
 
-			if err := backoff.RetryNotify(operation, bo, func(err error, timer time.Duration) {
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
 				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
 			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
 				b.log.WithError(err).Warn("Failed to process")
 			}
 		}
@@ -196,7 +276,9 @@ func (b *BLSToExecutionChangeDeriver) processEpoch(ctx context.Context, epoch ph
 	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
 		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
 
-		events, err := b.processSlot(ctx, slot)
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
 		}
@@ -215,13 +297,16 @@ func (b *BLSToExecutionChangeDeriver) processSlot(ctx context.Context, slot phas
 	defer span.End()
 
 	// Get the block
-	block, err := b.beacon.GetBeaconBlock(ctx, xatuethv1.SlotAsString(slot))
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
-	}
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
 
-	if block == nil {
-		return []*xatu.DecoratedEvent{}, nil
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
 	}
 
 	blockIdentifier, err := GetBlockIdentifier(block, b.beacon.Metadata().Wallclock())
@@ -274,7 +359,7 @@ func (b *BLSToExecutionChangeDeriver) getBLSToExecutionChanges(ctx context.Conte
 
 func (b *BLSToExecutionChangeDeriver) createEvent(ctx context.Context, change *xatuethv2.SignedBLSToExecutionChangeV2, identifier *xatu.BlockIdentifier) (*xatu.DecoratedEvent, error) {
 	// Make a clone of the metadata
-	metadata, ok := proto.Clone(b.clientMeta).(*xatu.ClientMeta)
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
 	if !ok {
 		return nil, errors.New("failed to clone client metadata")
 	}
@@ -293,11 +378,43 @@ func (b *BLSToExecutionChangeDeriver) createEvent(ctx context.Context, change *x
 		},
 	}
 
+	additionalData := &xatu.ClientMeta_AdditionalEthV2BeaconBlockBLSToExecutionChangeData{
+		Block: identifier,
+	}
+
+	if b.cfg.ResolveWithdrawalCredentials {
+		address, credentials, err := executionWithdrawalCredentials(change.GetMessage().GetToExecutionAddress())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve withdrawal credentials")
+		}
+
+		additionalData.WithdrawalAddress = address
+		additionalData.WithdrawalCredentials = credentials
+	}
+
 	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV2BeaconBlockBlsToExecutionChange{
-		EthV2BeaconBlockBlsToExecutionChange: &xatu.ClientMeta_AdditionalEthV2BeaconBlockBLSToExecutionChangeData{
-			Block: identifier,
-		},
+		EthV2BeaconBlockBlsToExecutionChange: additionalData,
 	}
 
 	return decoratedEvent, nil
 }
+
+// executionWithdrawalCredentials returns the normalised execution address and the 0x01 withdrawal credentials
+// (0x01 prefix, 11 zero bytes, then the 20 byte address) a validator ends up with after the change.
+func executionWithdrawalCredentials(toExecutionAddress string) (address, credentials string, err error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(toExecutionAddress), "0x"))
+	if err != nil {
+		return "", "", errors.Wrapf(err, "invalid execution address %s", toExecutionAddress)
+	}
+
+	if len(raw) != 20 {
+		return "", "", fmt.Errorf("invalid execution address %s: expected 20 bytes, got %d", toExecutionAddress, len(raw))
+	}
+
+	creds := make([]byte, 0, 32)
+	creds = append(creds, 0x01)
+	creds = append(creds, make([]byte, 11)...)
+	creds = append(creds, raw...)
+
+	return "0x" + hex.EncodeToString(raw), "0x" + hex.EncodeToString(creds), nil
+}
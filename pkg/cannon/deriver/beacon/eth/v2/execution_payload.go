@@ -0,0 +1,433 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
+	xatuethv2 "github.com/ethpandaops/xatu/pkg/proto/eth/v2"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	ExecutionPayloadDeriverName = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD
+)
+
+type ExecutionPayloadDeriverConfig struct {
+	Enabled        bool               `yaml:"enabled" default:"true"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	Batch          eventbatch.Config  `yaml:",inline"`
+	Dedup          dedup.Config       `yaml:"dedup"`
+}
+
+type ExecutionPayloadDeriver struct {
+	log                 logrus.FieldLogger
+	cfg                 *ExecutionPayloadDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+}
+
+func NewExecutionPayloadDeriver(log logrus.FieldLogger, config *ExecutionPayloadDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *ExecutionPayloadDeriver {
+	return &ExecutionPayloadDeriver{
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/execution_payload"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
+	}
+}
+
+func (b *ExecutionPayloadDeriver) CannonType() xatu.CannonType {
+	return ExecutionPayloadDeriverName
+}
+
+func (b *ExecutionPayloadDeriver) Name() string {
+	return ExecutionPayloadDeriverName.String()
+}
+
+func (b *ExecutionPayloadDeriver) OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) {
+	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
+}
+
+func (b *ExecutionPayloadDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
+func (b *ExecutionPayloadDeriver) Start(ctx context.Context) error {
+	if !b.cfg.Enabled {
+		b.log.Info("Execution payload deriver disabled")
+
+		return nil
+	}
+
+	b.log.Info("Execution payload deriver enabled")
+
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
+	// Start our main loop
+	go b.run(ctx)
+
+	return nil
+}
+
+func (b *ExecutionPayloadDeriver) Stop(ctx context.Context) error {
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *ExecutionPayloadDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 3 * time.Minute
+
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-b.stopCtx.Done():
+			return
+		default:
+			operation := func() error {
+				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
+					trace.WithAttributes(
+						attribute.String("network", string(b.beacon.Metadata().Network.Name))),
+				)
+				defer span.End()
+
+				time.Sleep(100 * time.Millisecond)
+
+				if err := b.beacon.Synced(ctx); err != nil {
+					return err
+				}
+
+				// Get the next slot
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
+				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
+					return err
+				}
+
+				// Look ahead
+				b.lookAheadAtLocation(ctx, lookAhead)
+
+				// Process the epoch
+				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockExecutionPayload().GetEpoch()))
+				b.breaker.Record(err)
+
+				if err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
+
+					return err
+				}
+
+				events = b.iterator.FilterSlotRange(events)
+
+				for _, fn := range b.onEventsCallbacks {
+					if errr := fn(ctx, events); errr != nil {
+						return errors.Wrapf(errr, "failed to send events")
+					}
+				}
+
+				// Update our location
+				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
+					return err
+				}
+
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlockExecutionPayload().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
+				bo.Reset()
+
+				return nil
+			}
+
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
+				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
+			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
+				b.log.WithError(err).Warn("Failed to process")
+			}
+		}
+	}
+}
+
+func (b *ExecutionPayloadDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"ExecutionPayloadDeriver.processEpoch",
+		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
+	)
+	defer span.End()
+
+	sp, err := b.beacon.Node().Spec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	allEvents := []*xatu.DecoratedEvent{}
+
+	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
+		}
+
+		allEvents = append(allEvents, events...)
+	}
+
+	return allEvents, nil
+}
+
+func (b *ExecutionPayloadDeriver) processSlot(ctx context.Context, slot phase0.Slot) ([]*xatu.DecoratedEvent, error) {
+	ctx, span := observability.Tracer().Start(ctx,
+		"ExecutionPayloadDeriver.processSlot",
+		trace.WithAttributes(attribute.Int64("slot", int64(slot))),
+	)
+	defer span.End()
+
+	// Get the block
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
+	if err != nil {
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
+	}
+
+	blockIdentifier, err := GetBlockIdentifier(block, b.beacon.Metadata().Wallclock())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get block identifier for slot %d", slot)
+	}
+
+	// Execution payloads were introduced in Bellatrix.
+	switch block.Version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair:
+		return []*xatu.DecoratedEvent{}, nil
+	}
+
+	payload, err := getExecutionPayloadSummary(block)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get execution payload for slot %d", slot)
+	}
+
+	event, err := b.createEvent(ctx, payload, blockIdentifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create event for execution payload in slot %d", slot)
+	}
+
+	return []*xatu.DecoratedEvent{event}, nil
+}
+
+// lookAheadAtLocation takes the upcoming locations and looks ahead to do any pre-processing that might be required.
+func (b *ExecutionPayloadDeriver) lookAheadAtLocation(ctx context.Context, locations []*xatu.CannonLocation) {
+	_, span := observability.Tracer().Start(ctx,
+		"ExecutionPayloadDeriver.lookAheadAtLocations",
+	)
+	defer span.End()
+
+	if locations == nil {
+		return
+	}
+
+	for _, location := range locations {
+		// Get the next look ahead epoch
+		epoch := phase0.Epoch(location.GetEthV2BeaconBlockExecutionPayload().GetEpoch())
+
+		sp, err := b.beacon.Node().Spec()
+		if err != nil {
+			b.log.WithError(err).WithField("epoch", epoch).Warn("Failed to look ahead at epoch")
+
+			return
+		}
+
+		for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
+			slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+
+			// Add the block to the preload queue so it's available when we need it
+			b.beacon.LazyLoadBeaconBlock(xatuethv1.SlotAsString(slot))
+		}
+	}
+}
+
+func getExecutionPayloadSummary(block *spec.VersionedSignedBeaconBlock) (*xatuethv2.ExecutionPayloadSummary, error) {
+	summary := &xatuethv2.ExecutionPayloadSummary{}
+
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil || block.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no bellatrix execution payload")
+		}
+
+		payload := block.Bellatrix.Message.Body.ExecutionPayload
+
+		setExecutionPayloadSummary(summary, payload.BlockNumber, payload.BlockHash, payload.ParentHash, payload.FeeRecipient,
+			payload.StateRoot, payload.ReceiptsRoot, payload.GasLimit, payload.GasUsed, payload.Timestamp, payload.ExtraData, len(payload.Transactions))
+
+		summary.BaseFeePerGas = littleEndianToDecimal(payload.BaseFeePerGas)
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil || block.Capella.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+
+		payload := block.Capella.Message.Body.ExecutionPayload
+
+		setExecutionPayloadSummary(summary, payload.BlockNumber, payload.BlockHash, payload.ParentHash, payload.FeeRecipient,
+			payload.StateRoot, payload.ReceiptsRoot, payload.GasLimit, payload.GasUsed, payload.Timestamp, payload.ExtraData, len(payload.Transactions))
+
+		summary.BaseFeePerGas = littleEndianToDecimal(payload.BaseFeePerGas)
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil || block.Deneb.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no deneb execution payload")
+		}
+
+		payload := block.Deneb.Message.Body.ExecutionPayload
+
+		setExecutionPayloadSummary(summary, payload.BlockNumber, payload.BlockHash, payload.ParentHash, payload.FeeRecipient,
+			payload.StateRoot, payload.ReceiptsRoot, payload.GasLimit, payload.GasUsed, payload.Timestamp, payload.ExtraData, len(payload.Transactions))
+
+		if payload.BaseFeePerGas != nil {
+			summary.BaseFeePerGas = payload.BaseFeePerGas.ToBig().String()
+		}
+
+		summary.BlobGasUsed = wrapperspb.UInt64(payload.BlobGasUsed)
+		summary.ExcessBlobGas = wrapperspb.UInt64(payload.ExcessBlobGas)
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", block.Version.String())
+	}
+
+	return summary, nil
+}
+
+func setExecutionPayloadSummary(summary *xatuethv2.ExecutionPayloadSummary, blockNumber uint64, blockHash, parentHash phase0.Hash32, feeRecipient bellatrix.ExecutionAddress, stateRoot, receiptsRoot [32]byte, gasLimit, gasUsed, timestamp uint64, extraData []byte, transactions int) {
+	summary.BlockNumber = wrapperspb.UInt64(blockNumber)
+	summary.BlockHash = blockHash.String()
+	summary.ParentHash = parentHash.String()
+	summary.FeeRecipient = feeRecipient.String()
+	summary.StateRoot = fmt.Sprintf("%#x", stateRoot)
+	summary.ReceiptsRoot = fmt.Sprintf("%#x", receiptsRoot)
+	summary.GasLimit = wrapperspb.UInt64(gasLimit)
+	summary.GasUsed = wrapperspb.UInt64(gasUsed)
+	summary.Timestamp = wrapperspb.UInt64(timestamp)
+	summary.ExtraData = fmt.Sprintf("%#x", extraData)
+	summary.TransactionsCount = wrapperspb.UInt64(uint64(transactions))
+}
+
+// littleEndianToDecimal converts a little endian SSZ uint256 to a decimal string.
+func littleEndianToDecimal(value [32]byte) string {
+	bigEndian := make([]byte, len(value))
+	for i := range value {
+		bigEndian[i] = value[len(value)-1-i]
+	}
+
+	return new(big.Int).SetBytes(bigEndian).String()
+}
+
+func (b *ExecutionPayloadDeriver) createEvent(ctx context.Context, payload *xatuethv2.ExecutionPayloadSummary, identifier *xatu.BlockIdentifier) (*xatu.DecoratedEvent, error) {
+	// Make a clone of the metadata
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
+	if !ok {
+		return nil, errors.New("failed to clone client metadata")
+	}
+
+	decoratedEvent := &xatu.DecoratedEvent{
+		Event: &xatu.Event{
+			Name:     xatu.Event_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD,
+			DateTime: timestamppb.New(time.Now()),
+			Id:       uuid.New().String(),
+		},
+		Meta: &xatu.Meta{
+			Client: metadata,
+		},
+		Data: &xatu.DecoratedEvent_EthV2BeaconBlockExecutionPayload{
+			EthV2BeaconBlockExecutionPayload: payload,
+		},
+	}
+
+	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV2BeaconBlockExecutionPayload{
+		EthV2BeaconBlockExecutionPayload: &xatu.ClientMeta_AdditionalEthV2BeaconBlockExecutionPayloadData{
+			Block: identifier,
+		},
+	}
+
+	return decoratedEvent, nil
+}
@@ -11,7 +11,11 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
@@ -21,35 +25,80 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type ExecutionTransactionDeriver struct {
-	log               logrus.FieldLogger
-	cfg               *ExecutionTransactionDeriverConfig
-	iterator          *iterator.CheckpointIterator
-	onEventsCallbacks []func(ctx context.Context, events []*xatu.DecoratedEvent) error
-	beacon            *ethereum.BeaconNode
-	clientMeta        *xatu.ClientMeta
+	log                 logrus.FieldLogger
+	cfg                 *ExecutionTransactionDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	metrics             *Metrics
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
+	// emitted records how many slots of a partially processed epoch have already had their events emitted, so
+	// retrying the epoch doesn't emit them again.
+	emitted emittedSlots
+}
+
+type emittedSlots struct {
+	epoch phase0.Epoch
+	slots int
 }
 
 type ExecutionTransactionDeriverConfig struct {
-	Enabled bool `yaml:"enabled" default:"true"`
+	Enabled        bool               `yaml:"enabled" default:"true"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	// Concurrency is the number of slots within an epoch that are processed in parallel. The location is stored per
+	// epoch, so it only advances once every slot of the epoch has been processed.
+	Concurrency int `yaml:"concurrency" default:"1"`
+	// MaxInFlightBlocks caps how many blocks are held between being fetched and their events being emitted.
+	// Events are emitted slot by slot once the cap is reached. 0 disables the cap.
+	MaxInFlightBlocks int `yaml:"maxInFlightBlocks" default:"0"`
+	// IncludeRawTransaction attaches the encoded transaction to each event. Off by default as it increases the event size.
+	IncludeRawTransaction bool              `yaml:"includeRawTransaction" default:"false"`
+	Batch                 eventbatch.Config `yaml:",inline"`
+	Dedup                 dedup.Config      `yaml:"dedup"`
+}
+
+func (c *ExecutionTransactionDeriverConfig) Validate() error {
+	if c.Concurrency < 1 {
+		return errors.New("concurrency must be greater than 0")
+	}
+
+	if c.MaxInFlightBlocks < 0 {
+		return errors.New("maxInFlightBlocks must be 0 or greater")
+	}
+
+	return nil
 }
 
 const (
 	ExecutionTransactionDeriverName = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_TRANSACTION
 )
 
-func NewExecutionTransactionDeriver(log logrus.FieldLogger, config *ExecutionTransactionDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, clientMeta *xatu.ClientMeta) *ExecutionTransactionDeriver {
+func NewExecutionTransactionDeriver(log logrus.FieldLogger, config *ExecutionTransactionDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta, metrics *Metrics) *ExecutionTransactionDeriver {
 	return &ExecutionTransactionDeriver{
-		log:        log.WithField("module", "cannon/event/beacon/eth/v2/execution_transaction"),
-		cfg:        config,
-		iterator:   iter,
-		beacon:     beacon,
-		clientMeta: clientMeta,
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/execution_transaction"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
+		metrics:       metrics,
 	}
 }
 
@@ -65,6 +114,10 @@ func (b *ExecutionTransactionDeriver) OnEventsDerived(ctx context.Context, fn fu
 	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
 }
 
+func (b *ExecutionTransactionDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
 func (b *ExecutionTransactionDeriver) Start(ctx context.Context) error {
 	if !b.cfg.Enabled {
 		b.log.Info("Execution transaction deriver disabled")
@@ -74,6 +127,15 @@ func (b *ExecutionTransactionDeriver) Start(ctx context.Context) error {
 
 	b.log.Info("Execution transaction deriver enabled")
 
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
 	// Start our main loop
 	go b.run(ctx)
 
@@ -81,10 +143,24 @@ func (b *ExecutionTransactionDeriver) Start(ctx context.Context) error {
 }
 
 func (b *ExecutionTransactionDeriver) Stop(ctx context.Context) error {
-	return nil
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (b *ExecutionTransactionDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxInterval = 3 * time.Minute
 
@@ -92,6 +168,8 @@ func (b *ExecutionTransactionDeriver) run(rctx context.Context) {
 		select {
 		case <-rctx.Done():
 			return
+		case <-b.stopCtx.Done():
+			return
 		default:
 			operation := func() error {
 				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
@@ -107,49 +185,78 @@ func (b *ExecutionTransactionDeriver) run(rctx context.Context) {
 				}
 
 				// Get the next slot
-				location, lookAhead, err := b.iterator.Next(ctx)
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
 				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
 					return err
 				}
 
 				// Look ahead
 				b.lookAheadAtLocation(ctx, lookAhead)
 
-				// Process the epoch
-				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockExecutionTransaction().GetEpoch()))
-				if err != nil {
-					b.log.WithError(err).Error("Failed to process epoch")
+				// Process the epoch and send the events
+				if err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockExecutionTransaction().GetEpoch()), b.sendEvents); err != nil {
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
 
 					return err
 				}
 
-				// Send the events
-				for _, fn := range b.onEventsCallbacks {
-					if err := fn(ctx, events); err != nil {
-						return errors.Wrap(err, "failed to send events")
-					}
-				}
-
 				// Update our location
 				if err := b.iterator.UpdateLocation(ctx, location); err != nil {
 					return err
 				}
 
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlockExecutionTransaction().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
 				bo.Reset()
 
 				return nil
 			}
 
-			if err := backoff.RetryNotify(operation, bo, func(err error, timer time.Duration) {
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
 				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
 			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
 				b.log.WithError(err).Warn("Failed to process")
 			}
 		}
 	}
 }
 
-func (b *ExecutionTransactionDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch) ([]*xatu.DecoratedEvent, error) {
+func (b *ExecutionTransactionDeriver) sendEvents(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	events = b.iterator.FilterSlotRange(events)
+
+	for _, fn := range b.onEventsCallbacks {
+		if err := fn(ctx, events); err != nil {
+			return errors.Wrap(err, "failed to send events")
+		}
+	}
+
+	return nil
+}
+
+func (b *ExecutionTransactionDeriver) processEpoch(ctx context.Context, epoch phase0.Epoch, emit func(ctx context.Context, events []*xatu.DecoratedEvent) error) error {
 	ctx, span := observability.Tracer().Start(ctx,
 		"ExecutionTransactionDeriver.processEpoch",
 		trace.WithAttributes(attribute.Int64("epoch", int64(epoch))),
@@ -158,23 +265,110 @@ func (b *ExecutionTransactionDeriver) processEpoch(ctx context.Context, epoch ph
 
 	sp, err := b.beacon.Node().Spec()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to obtain spec")
+		return errors.Wrap(err, "failed to obtain spec")
 	}
 
-	allEvents := []*xatu.DecoratedEvent{}
+	slots := int(sp.SlotsPerEpoch)
 
-	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
-		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+	maxInFlight := slots
+	if b.cfg.MaxInFlightBlocks > 0 && b.cfg.MaxInFlightBlocks < slots {
+		maxInFlight = b.cfg.MaxInFlightBlocks
+	}
 
-		events, err := b.processSlot(ctx, slot)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
+	// Slots are processed concurrently but the results are emitted in slot order. Without a cap the
+	// whole epoch is emitted at once. With a cap, no new slot is started while maxInFlight slots are
+	// waiting to be emitted; the oldest slot is emitted first to make room. The location is only
+	// advanced once every slot in the epoch has been processed. If the epoch fails part way through, the
+	// retry resumes after the slots that were already emitted.
+	slotEvents := make([][]*xatu.DecoratedEvent, slots)
+	slotDone := make([]chan struct{}, slots)
+
+	gCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	g, gCtx := errgroup.WithContext(gCtx)
+	g.SetLimit(b.cfg.Concurrency)
+
+	emitted := 0
+	if b.emitted.epoch == epoch {
+		emitted = b.emitted.slots
+	}
+
+	defer b.metrics.SetInFlightBlocks(0, b.Name())
+
+	for i := emitted; i < slots; i++ {
+		for i-emitted >= maxInFlight {
+			select {
+			case <-slotDone[emitted]:
+			case <-gCtx.Done():
+				if err := g.Wait(); err != nil {
+					b.breaker.Record(err)
+
+					return err
+				}
+
+				return gCtx.Err()
+			}
+
+			if err := emit(ctx, slotEvents[emitted]); err != nil {
+				cancel()
+
+				_ = g.Wait()
+
+				return err
+			}
+
+			slotEvents[emitted] = nil
+			emitted++
+
+			b.emitted = emittedSlots{epoch: epoch, slots: emitted}
+
+			b.metrics.SetInFlightBlocks(i-emitted, b.Name())
 		}
 
-		allEvents = append(allEvents, events...)
+		i := i
+		slot := phase0.Slot(uint64(i) + uint64(epoch)*uint64(sp.SlotsPerEpoch))
+		slotDone[i] = make(chan struct{})
+
+		b.metrics.SetInFlightBlocks(i+1-emitted, b.Name())
+
+		g.Go(func() error {
+			events, err := b.slotDeadlines.Process(gCtx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+				return b.processSlot(ctx, slot)
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to process slot %d", slot)
+			}
+
+			slotEvents[i] = events
+
+			close(slotDone[i])
+
+			return nil
+		})
+	}
+
+	// Only the slots' fetches and processing count towards the circuit breaker, not emitting their events.
+	err = g.Wait()
+	b.breaker.Record(err)
+
+	if err != nil {
+		return err
+	}
+
+	remaining := []*xatu.DecoratedEvent{}
+
+	for _, events := range slotEvents[emitted:] {
+		remaining = append(remaining, events...)
 	}
 
-	return allEvents, nil
+	if err := emit(ctx, remaining); err != nil {
+		return err
+	}
+
+	b.emitted = emittedSlots{}
+
+	return nil
 }
 
 // lookAheadAtLocation takes the upcoming locations and looks ahead to do any pre-processing that might be required.
@@ -212,13 +406,16 @@ func (b *ExecutionTransactionDeriver) processSlot(ctx context.Context, slot phas
 	defer span.End()
 
 	// Get the block
-	block, err := b.beacon.GetBeaconBlock(ctx, xatuethv1.SlotAsString(slot))
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
-	}
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
 
-	if block == nil {
-		return []*xatu.DecoratedEvent{}, nil
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
 	}
 
 	blockIdentifier, err := GetBlockIdentifier(block, b.beacon.Metadata().Wallclock())
@@ -233,6 +430,25 @@ func (b *ExecutionTransactionDeriver) processSlot(ctx context.Context, slot phas
 		return nil, err
 	}
 
+	if len(transactions) == 0 {
+		return events, nil
+	}
+
+	executionBlockNumber, err := block.ExecutionBlockNumber()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get execution block number for slot %d", slot)
+	}
+
+	executionBlockHash, err := block.ExecutionBlockHash()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get execution block hash for slot %d", slot)
+	}
+
+	executionBlock := &executionBlockInfo{
+		number: executionBlockNumber,
+		hash:   executionBlockHash.String(),
+	}
+
 	for index, transaction := range transactions {
 		from, err := types.Sender(types.LatestSignerForChainID(transaction.ChainId()), transaction)
 		if err != nil {
@@ -273,7 +489,7 @@ func (b *ExecutionTransactionDeriver) processSlot(ctx context.Context, slot phas
 			Type:     wrapperspb.UInt32(uint32(transaction.Type())),
 		}
 
-		event, err := b.createEvent(ctx, tx, uint64(index), blockIdentifier, transaction)
+		event, err := b.createEvent(ctx, tx, uint64(index), blockIdentifier, executionBlock, transaction)
 		if err != nil {
 			b.log.WithError(err).Error("Failed to create event")
 
@@ -299,14 +515,22 @@ func (b *ExecutionTransactionDeriver) getExecutionTransactions(ctx context.Conte
 		if err := ethTransaction.UnmarshalBinary(transaction); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal transaction: %v", err)
 		}
+
+		transactions = append(transactions, ethTransaction)
 	}
 
 	return transactions, nil
 }
 
-func (b *ExecutionTransactionDeriver) createEvent(ctx context.Context, transaction *xatuethv1.Transaction, positionInBlock uint64, blockIdentifier *xatu.BlockIdentifier, rlpTransaction *types.Transaction) (*xatu.DecoratedEvent, error) {
+// executionBlockInfo identifies the execution block a beacon block's transactions belong to.
+type executionBlockInfo struct {
+	number uint64
+	hash   string
+}
+
+func (b *ExecutionTransactionDeriver) createEvent(ctx context.Context, transaction *xatuethv1.Transaction, positionInBlock uint64, blockIdentifier *xatu.BlockIdentifier, executionBlock *executionBlockInfo, rlpTransaction *types.Transaction) (*xatu.DecoratedEvent, error) {
 	// Make a clone of the metadata
-	metadata, ok := proto.Clone(b.clientMeta).(*xatu.ClientMeta)
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
 	if !ok {
 		return nil, errors.New("failed to clone client metadata")
 	}
@@ -325,13 +549,27 @@ func (b *ExecutionTransactionDeriver) createEvent(ctx context.Context, transacti
 		},
 	}
 
+	additionalData := &xatu.ClientMeta_AdditionalEthV2BeaconBlockExecutionTransactionData{
+		Block:           blockIdentifier,
+		PositionInBlock: wrapperspb.UInt64(positionInBlock),
+		Size:            strconv.FormatFloat(float64(rlpTransaction.Size()), 'f', 0, 64),
+		CallDataSize:    fmt.Sprintf("%d", len(rlpTransaction.Data())),
+
+		ExecutionBlockNumber: wrapperspb.UInt64(executionBlock.number),
+		ExecutionBlockHash:   executionBlock.hash,
+	}
+
+	if b.cfg.IncludeRawTransaction {
+		raw, err := rlpTransaction.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode transaction")
+		}
+
+		additionalData.RawTransaction = fmt.Sprintf("%#x", raw)
+	}
+
 	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV2BeaconBlockExecutionTransaction{
-		EthV2BeaconBlockExecutionTransaction: &xatu.ClientMeta_AdditionalEthV2BeaconBlockExecutionTransactionData{
-			Block:           blockIdentifier,
-			PositionInBlock: wrapperspb.UInt64(positionInBlock),
-			Size:            strconv.FormatFloat(float64(rlpTransaction.Size()), 'f', 0, 64),
-			CallDataSize:    fmt.Sprintf("%d", len(rlpTransaction.Data())),
-		},
+		EthV2BeaconBlockExecutionTransaction: additionalData,
 	}
 
 	return decoratedEvent, nil
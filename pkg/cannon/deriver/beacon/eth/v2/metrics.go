@@ -0,0 +1,25 @@
+package v2
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type Metrics struct {
+	inFlightBlocks *prometheus.GaugeVec
+}
+
+func NewMetrics(namespace string, registerer prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		inFlightBlocks: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "deriver_in_flight_blocks",
+			Help:      "Number of blocks a deriver has started processing but not yet emitted",
+		}, []string{"type"}),
+	}
+
+	registerer.MustRegister(m.inFlightBlocks)
+
+	return m
+}
+
+func (m *Metrics) SetInFlightBlocks(count int, cannonType string) {
+	m.inFlightBlocks.WithLabelValues(cannonType).Set(float64(count))
+}
@@ -8,7 +8,11 @@ import (
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	xatuethv1 "github.com/ethpandaops/xatu/pkg/proto/eth/v1"
@@ -28,25 +32,42 @@ const (
 )
 
 type VoluntaryExitDeriverConfig struct {
-	Enabled bool `yaml:"enabled" default:"true"`
+	Enabled        bool               `yaml:"enabled" default:"true"`
+	StartEpoch     *uint64            `yaml:"startEpoch"`
+	SlotRange      iterator.SlotRange `yaml:",inline"`
+	RequestTimeout time.Duration      `yaml:"requestTimeout"`
+	Deadline       deadline.Config    `yaml:",inline"`
+	// ResolveExitEpochs attaches the validator's exit and withdrawable epochs from the state after the block to
+	// each event. Requires a beacon node that can serve historical states when backfilling.
+	ResolveExitEpochs bool              `yaml:"resolveExitEpochs" default:"false"`
+	Batch             eventbatch.Config `yaml:",inline"`
+	Dedup             dedup.Config      `yaml:"dedup"`
 }
 
 type VoluntaryExitDeriver struct {
-	log               logrus.FieldLogger
-	cfg               *VoluntaryExitDeriverConfig
-	iterator          *iterator.CheckpointIterator
-	onEventsCallbacks []func(ctx context.Context, events []*xatu.DecoratedEvent) error
-	beacon            *ethereum.BeaconNode
-	clientMeta        *xatu.ClientMeta
+	log                 logrus.FieldLogger
+	cfg                 *VoluntaryExitDeriverConfig
+	iterator            *iterator.CheckpointIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	slotDeadlines       *deadline.Tracker
+	clientMeta          func() *xatu.ClientMeta
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
 }
 
-func NewVoluntaryExitDeriver(log logrus.FieldLogger, config *VoluntaryExitDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, clientMeta *xatu.ClientMeta) *VoluntaryExitDeriver {
+func NewVoluntaryExitDeriver(log logrus.FieldLogger, config *VoluntaryExitDeriverConfig, iter *iterator.CheckpointIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, slotDeadlines *deadline.Tracker, clientMeta func() *xatu.ClientMeta) *VoluntaryExitDeriver {
 	return &VoluntaryExitDeriver{
-		log:        log.WithField("module", "cannon/event/beacon/eth/v2/voluntary_exit"),
-		cfg:        config,
-		iterator:   iter,
-		beacon:     beacon,
-		clientMeta: clientMeta,
+		log:           log.WithField("module", "cannon/event/beacon/eth/v2/voluntary_exit"),
+		cfg:           config,
+		iterator:      iter,
+		beacon:        beacon,
+		breaker:       breaker,
+		slotDeadlines: slotDeadlines,
+		clientMeta:    clientMeta,
 	}
 }
 
@@ -62,6 +83,10 @@ func (b *VoluntaryExitDeriver) OnEventsDerived(ctx context.Context, fn func(ctx
 	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
 }
 
+func (b *VoluntaryExitDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
 func (b *VoluntaryExitDeriver) Start(ctx context.Context) error {
 	if !b.cfg.Enabled {
 		b.log.Info("Voluntary exit deriver disabled")
@@ -71,6 +96,15 @@ func (b *VoluntaryExitDeriver) Start(ctx context.Context) error {
 
 	b.log.Info("Voluntary exit deriver enabled")
 
+	if b.cfg.StartEpoch != nil {
+		b.iterator.SetStartEpoch(phase0.Epoch(*b.cfg.StartEpoch))
+	}
+
+	b.iterator.SetSlotRange(b.cfg.SlotRange)
+
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
 	// Start our main loop
 	go b.run(ctx)
 
@@ -78,10 +112,24 @@ func (b *VoluntaryExitDeriver) Start(ctx context.Context) error {
 }
 
 func (b *VoluntaryExitDeriver) Stop(ctx context.Context) error {
-	return nil
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (b *VoluntaryExitDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxInterval = 3 * time.Minute
 
@@ -89,6 +137,8 @@ func (b *VoluntaryExitDeriver) run(rctx context.Context) {
 		select {
 		case <-rctx.Done():
 			return
+		case <-b.stopCtx.Done():
+			return
 		default:
 			operation := func() error {
 				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
@@ -104,8 +154,15 @@ func (b *VoluntaryExitDeriver) run(rctx context.Context) {
 				}
 
 				// Get the next slot
-				location, lookAhead, err := b.iterator.Next(ctx)
+				nextCtx, cancelNext := iterator.WithStop(ctx, b.stopCtx)
+				defer cancelNext()
+
+				location, lookAhead, err := b.iterator.Next(nextCtx)
 				if err != nil {
+					if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+						return backoff.Permanent(err)
+					}
+
 					return err
 				}
 
@@ -114,12 +171,18 @@ func (b *VoluntaryExitDeriver) run(rctx context.Context) {
 
 				// Process the epoch
 				events, err := b.processEpoch(ctx, phase0.Epoch(location.GetEthV2BeaconBlockVoluntaryExit().GetEpoch()))
+				b.breaker.Record(err)
+
 				if err != nil {
-					b.log.WithError(err).Error("Failed to process epoch")
+					if !b.breaker.IsOpen() {
+						b.log.WithError(err).Error("Failed to process epoch")
+					}
 
 					return err
 				}
 
+				events = b.iterator.FilterSlotRange(events)
+
 				// Send the events
 				for _, fn := range b.onEventsCallbacks {
 					if err := fn(ctx, events); err != nil {
@@ -132,14 +195,30 @@ func (b *VoluntaryExitDeriver) run(rctx context.Context) {
 					return err
 				}
 
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetEthV2BeaconBlockVoluntaryExit().GetEpoch()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
 				bo.Reset()
 
 				return nil
 			}
 
-			if err := backoff.RetryNotify(operation, bo, func(err error, timer time.Duration) {
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
 				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
 			}); err != nil {
+				if errors.Is(err, iterator.ErrCheckpointIteratorFinished) {
+					b.log.Info("Reached the end of the configured slot range, stopping deriver")
+
+					return
+				}
+
 				b.log.WithError(err).Warn("Failed to process")
 			}
 		}
@@ -194,7 +273,9 @@ func (b *VoluntaryExitDeriver) processEpoch(ctx context.Context, epoch phase0.Ep
 	for i := uint64(0); i <= uint64(sp.SlotsPerEpoch-1); i++ {
 		slot := phase0.Slot(i + uint64(epoch)*uint64(sp.SlotsPerEpoch))
 
-		events, err := b.processSlot(ctx, slot)
+		events, err := b.slotDeadlines.Process(ctx, b.CannonType(), &b.cfg.Deadline, slot, func(ctx context.Context) ([]*xatu.DecoratedEvent, error) {
+			return b.processSlot(ctx, slot)
+		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to process slot %d", slot)
 		}
@@ -213,13 +294,16 @@ func (b *VoluntaryExitDeriver) processSlot(ctx context.Context, slot phase0.Slot
 	defer span.End()
 
 	// Get the block
-	block, err := b.beacon.GetBeaconBlock(ctx, xatuethv1.SlotAsString(slot))
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	block, err := b.beacon.GetBeaconBlock(fetchCtx, xatuethv1.SlotAsString(slot))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
-	}
+		if errors.Is(err, ethereum.ErrSlotSkipped) {
+			return []*xatu.DecoratedEvent{}, nil
+		}
 
-	if block == nil {
-		return []*xatu.DecoratedEvent{}, nil
+		return nil, errors.Wrapf(err, "failed to get beacon block for slot %d", slot)
 	}
 
 	blockIdentifier, err := GetBlockIdentifier(block, b.beacon.Metadata().Wallclock())
@@ -235,7 +319,7 @@ func (b *VoluntaryExitDeriver) processSlot(ctx context.Context, slot phase0.Slot
 	}
 
 	for _, exit := range exits {
-		event, err := b.createEvent(ctx, exit, blockIdentifier)
+		event, err := b.createEvent(ctx, exit, blockIdentifier, slot)
 		if err != nil {
 			b.log.WithError(err).Error("Failed to create event")
 
@@ -269,9 +353,9 @@ func (b *VoluntaryExitDeriver) getVoluntaryExits(ctx context.Context, block *spe
 	return exits, nil
 }
 
-func (b *VoluntaryExitDeriver) createEvent(ctx context.Context, exit *xatuethv1.SignedVoluntaryExitV2, identifier *xatu.BlockIdentifier) (*xatu.DecoratedEvent, error) {
+func (b *VoluntaryExitDeriver) createEvent(ctx context.Context, exit *xatuethv1.SignedVoluntaryExitV2, identifier *xatu.BlockIdentifier, slot phase0.Slot) (*xatu.DecoratedEvent, error) {
 	// Make a clone of the metadata
-	metadata, ok := proto.Clone(b.clientMeta).(*xatu.ClientMeta)
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
 	if !ok {
 		return nil, errors.New("failed to clone client metadata")
 	}
@@ -290,11 +374,48 @@ func (b *VoluntaryExitDeriver) createEvent(ctx context.Context, exit *xatuethv1.
 		},
 	}
 
+	additionalData := &xatu.ClientMeta_AdditionalEthV2BeaconBlockVoluntaryExitData{
+		Block: identifier,
+	}
+
+	if b.cfg.ResolveExitEpochs {
+		if err := b.resolveExitEpochs(ctx, additionalData, slot, exit.GetMessage().GetValidatorIndex().GetValue()); err != nil {
+			return nil, err
+		}
+	}
+
 	decoratedEvent.Meta.Client.AdditionalData = &xatu.ClientMeta_EthV2BeaconBlockVoluntaryExit{
-		EthV2BeaconBlockVoluntaryExit: &xatu.ClientMeta_AdditionalEthV2BeaconBlockVoluntaryExitData{
-			Block: identifier,
-		},
+		EthV2BeaconBlockVoluntaryExit: additionalData,
 	}
 
 	return decoratedEvent, nil
 }
+
+// resolveExitEpochs looks up the validator in the state after the block, which is the first state with the exit
+// applied. Nodes that can't serve the state get the event flagged instead of failing the location.
+func (b *VoluntaryExitDeriver) resolveExitEpochs(ctx context.Context, data *xatu.ClientMeta_AdditionalEthV2BeaconBlockVoluntaryExitData, slot phase0.Slot, index uint64) error {
+	fetchCtx, cancel := ethereum.WithRequestTimeout(ctx, b.cfg.RequestTimeout)
+	defer cancel()
+
+	epochs, err := b.beacon.GetValidatorEpochs(fetchCtx, xatuethv1.SlotAsString(slot), index)
+	if err != nil {
+		if errors.Is(err, ethereum.ErrStateUnavailable) {
+			b.log.
+				WithError(err).
+				WithField("slot", slot).
+				WithField("validator_index", index).
+				Debug("Beacon node can not serve the state, emitting voluntary exit without exit epochs")
+
+			data.StateEnrichmentSkipped = true
+
+			return nil
+		}
+
+		return errors.Wrapf(err, "failed to get exit epochs of validator %d at slot %d", index, slot)
+	}
+
+	data.ExitEpoch = wrapperspb.UInt64(epochs.ExitEpoch)
+	data.WithdrawableEpoch = wrapperspb.UInt64(epochs.WithdrawableEpoch)
+
+	return nil
+}
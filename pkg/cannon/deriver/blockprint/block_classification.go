@@ -8,7 +8,10 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	backoff "github.com/cenkalti/backoff/v4"
 	aBlockprint "github.com/ethpandaops/xatu/pkg/cannon/blockprint"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
 	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
 	"github.com/ethpandaops/xatu/pkg/observability"
 	pBlockprint "github.com/ethpandaops/xatu/pkg/proto/blockprint"
@@ -32,6 +35,8 @@ type BlockClassificationDeriverConfig struct {
 	Endpoint  string            `yaml:"endpoint" default:"http://localhost:8080"`
 	Headers   map[string]string `yaml:"headers"`
 	BatchSize int               `yaml:"batchSize" default:"50"`
+	Batch     eventbatch.Config `yaml:",inline"`
+	Dedup     dedup.Config      `yaml:"dedup"`
 }
 
 func (c *BlockClassificationDeriverConfig) Validate() error {
@@ -43,21 +48,27 @@ func (c *BlockClassificationDeriverConfig) Validate() error {
 }
 
 type BlockClassificationDeriver struct {
-	log               logrus.FieldLogger
-	cfg               *BlockClassificationDeriverConfig
-	iterator          *iterator.BlockprintIterator
-	onEventsCallbacks []func(ctx context.Context, events []*xatu.DecoratedEvent) error
-	beacon            *ethereum.BeaconNode
-	clientMeta        *xatu.ClientMeta
-	blockprintClient  *aBlockprint.Client
+	log                 logrus.FieldLogger
+	cfg                 *BlockClassificationDeriverConfig
+	iterator            *iterator.BlockprintIterator
+	onEventsCallbacks   []func(ctx context.Context, events []*xatu.DecoratedEvent) error
+	onLocationCallbacks []func(ctx context.Context, location uint64) error
+	beacon              *ethereum.BeaconNode
+	breaker             *circuitbreaker.Breaker
+	clientMeta          func() *xatu.ClientMeta
+	blockprintClient    *aBlockprint.Client
+	stop                context.CancelFunc
+	stopCtx             context.Context
+	done                chan struct{}
 }
 
-func NewBlockClassificationDeriver(log logrus.FieldLogger, config *BlockClassificationDeriverConfig, iter *iterator.BlockprintIterator, beacon *ethereum.BeaconNode, clientMeta *xatu.ClientMeta, client *aBlockprint.Client) *BlockClassificationDeriver {
+func NewBlockClassificationDeriver(log logrus.FieldLogger, config *BlockClassificationDeriverConfig, iter *iterator.BlockprintIterator, beacon *ethereum.BeaconNode, breaker *circuitbreaker.Breaker, clientMeta func() *xatu.ClientMeta, client *aBlockprint.Client) *BlockClassificationDeriver {
 	return &BlockClassificationDeriver{
 		log:              log.WithField("module", "cannon/event/blockprint/block_classification"),
 		cfg:              config,
 		iterator:         iter,
 		beacon:           beacon,
+		breaker:          breaker,
 		clientMeta:       clientMeta,
 		blockprintClient: client,
 	}
@@ -75,6 +86,10 @@ func (b *BlockClassificationDeriver) OnEventsDerived(ctx context.Context, fn fun
 	b.onEventsCallbacks = append(b.onEventsCallbacks, fn)
 }
 
+func (b *BlockClassificationDeriver) OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error) {
+	b.onLocationCallbacks = append(b.onLocationCallbacks, fn)
+}
+
 func (b *BlockClassificationDeriver) Start(ctx context.Context) error {
 	if !b.cfg.Enabled {
 		b.log.Info("BlockClassification deriver disabled")
@@ -84,6 +99,9 @@ func (b *BlockClassificationDeriver) Start(ctx context.Context) error {
 
 	b.log.Info("BlockClassification deriver enabled")
 
+	b.stopCtx, b.stop = context.WithCancel(context.Background())
+	b.done = make(chan struct{})
+
 	// Start our main loop
 	go b.run(ctx)
 
@@ -91,10 +109,24 @@ func (b *BlockClassificationDeriver) Start(ctx context.Context) error {
 }
 
 func (b *BlockClassificationDeriver) Stop(ctx context.Context) error {
-	return nil
+	if b.stop == nil {
+		return nil
+	}
+
+	b.stop()
+
+	// Wait for the in-flight location to finish processing so it's persisted to the coordinator.
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (b *BlockClassificationDeriver) run(rctx context.Context) {
+	defer close(b.done)
+
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxInterval = 10 * time.Minute
 
@@ -102,6 +134,8 @@ func (b *BlockClassificationDeriver) run(rctx context.Context) {
 		select {
 		case <-rctx.Done():
 			return
+		case <-b.stopCtx.Done():
+			return
 		default:
 			operation := func() error {
 				ctx, span := observability.Tracer().Start(rctx, fmt.Sprintf("Derive %s", b.Name()),
@@ -135,6 +169,8 @@ func (b *BlockClassificationDeriver) run(rctx context.Context) {
 				}
 
 				events, err := b.processLocation(ctx, currentSlot, end)
+				b.breaker.Record(err)
+
 				if err != nil {
 					return errors.Wrapf(err, "failed to process location start_slot: %d end_slot: %d", currentSlot, end)
 				}
@@ -157,6 +193,12 @@ func (b *BlockClassificationDeriver) run(rctx context.Context) {
 					return err
 				}
 
+				for _, fn := range b.onLocationCallbacks {
+					if errr := fn(ctx, location.GetBlockprintBlockClassification().GetSlot()); errr != nil {
+						b.log.WithError(errr).Error("Failed to send location")
+					}
+				}
+
 				// Sleep if everything went well to avoid hammering the API
 				time.Sleep(5000 * time.Millisecond)
 
@@ -165,7 +207,11 @@ func (b *BlockClassificationDeriver) run(rctx context.Context) {
 				return nil
 			}
 
-			if err := backoff.RetryNotify(operation, bo, func(err error, timer time.Duration) {
+			if err := backoff.RetryNotify(b.breaker.Wrap(b.stopCtx, operation), backoff.WithContext(bo, b.stopCtx), func(err error, timer time.Duration) {
+				if b.breaker.IsOpen() {
+					return
+				}
+
 				b.log.WithError(err).WithField("next_attempt", timer).Warn("Failed to process")
 			}); err != nil {
 				b.log.WithError(err).Warn("Failed to process")
@@ -230,7 +276,7 @@ func (b *BlockClassificationDeriver) processBlocks(ctx context.Context, blocks [
 
 func (b *BlockClassificationDeriver) createEvent(ctx context.Context, classification *pBlockprint.BlockClassification, slot *xatu.SlotV2, epoch *xatu.EpochV2) (*xatu.DecoratedEvent, error) {
 	// Make a clone of the metadata
-	metadata, ok := proto.Clone(b.clientMeta).(*xatu.ClientMeta)
+	metadata, ok := proto.Clone(b.clientMeta()).(*xatu.ClientMeta)
 	if !ok {
 		return nil, errors.New("failed to clone client metadata")
 	}
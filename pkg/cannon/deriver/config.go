@@ -1,9 +1,17 @@
 package deriver
 
 import (
+	"context"
+
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/deadline"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
 	v1 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v1"
 	v2 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v2"
 	"github.com/ethpandaops/xatu/pkg/cannon/deriver/blockprint"
+	"github.com/ethpandaops/xatu/pkg/cannon/eventbatch"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
 	"github.com/pkg/errors"
 )
 
@@ -18,12 +26,157 @@ type Config struct {
 	BeaconBlockConfig          v2.BeaconBlockDeriverConfig                 `yaml:"beaconBlock"`
 	BlockClassificationConfig  blockprint.BlockClassificationDeriverConfig `yaml:"blockClassification"`
 	BeaconBlobSidecarConfig    v1.BeaconBlobDeriverConfig                  `yaml:"beaconBlobSidecar"`
+	SyncAggregateConfig        v2.SyncAggregateDeriverConfig               `yaml:"syncAggregate"`
+	BlockSummaryConfig         v2.BlockSummaryDeriverConfig                `yaml:"blockSummary"`
+	BeaconBlockRewardConfig    v1.BeaconBlockRewardDeriverConfig           `yaml:"beaconBlockReward"`
+	AttestationConfig          v2.AttestationDeriverConfig                 `yaml:"attestation"`
+	ExecutionPayloadConfig     v2.ExecutionPayloadDeriverConfig            `yaml:"executionPayload"`
+	ValidatorActivityConfig    v1.ValidatorActivityDeriverConfig           `yaml:"validatorActivity"`
+	LightClientUpdateConfig    v1.LightClientUpdateDeriverConfig           `yaml:"lightClientUpdate"`
+	ProposerDutyConfig         v1.ProposerDutyDeriverConfig                `yaml:"proposerDuty"`
+	BeaconCommitteeConfig      v1.BeaconCommitteeDeriverConfig             `yaml:"beaconCommittee"`
+
+	Iterator iterator.CheckpointConfig `yaml:",inline"`
+
+	// SkippedSlotsPath is the file slots skipped for exceeding their deadline are persisted to. Required when any
+	// deriver has skipSlotsPastDeadline enabled.
+	SkippedSlotsPath string `yaml:"skippedSlotsPath"`
+
+	// CircuitBreaker pauses a deriver after repeated consecutive failures. Applies to every deriver.
+	CircuitBreaker circuitbreaker.Config `yaml:"circuitBreaker"`
 }
 
 func (c *Config) Validate() error {
+	if err := c.AttesterSlashingConfig.Validate(); err != nil {
+		return errors.Wrap(err, "invalid attester slashing deriver config")
+	}
+
+	if err := c.ProposerSlashingConfig.Validate(); err != nil {
+		return errors.Wrap(err, "invalid proposer slashing deriver config")
+	}
+
 	if err := c.BlockClassificationConfig.Validate(); err != nil {
 		return errors.Wrap(err, "invalid block classification deriver config")
 	}
 
+	if err := c.ExecutionTransactionConfig.Validate(); err != nil {
+		return errors.Wrap(err, "invalid execution transaction deriver config")
+	}
+
+	if err := c.LightClientUpdateConfig.Validate(); err != nil {
+		return errors.Wrap(err, "invalid light client update deriver config")
+	}
+
+	deadlines := map[string]*deadline.Config{
+		"attesterSlashing":     &c.AttesterSlashingConfig.Deadline,
+		"blsToExecutionChange": &c.BLSToExecutionConfig.Deadline,
+		"deposit":              &c.DepositConfig.Deadline,
+		"executionTransaction": &c.ExecutionTransactionConfig.Deadline,
+		"proposerSlashing":     &c.ProposerSlashingConfig.Deadline,
+		"voluntaryExit":        &c.VoluntaryExitConfig.Deadline,
+		"withdrawal":           &c.WithdrawalConfig.Deadline,
+		"beaconBlock":          &c.BeaconBlockConfig.Deadline,
+		"beaconBlobSidecar":    &c.BeaconBlobSidecarConfig.Deadline,
+		"syncAggregate":        &c.SyncAggregateConfig.Deadline,
+		"blockSummary":         &c.BlockSummaryConfig.Deadline,
+		"beaconBlockReward":    &c.BeaconBlockRewardConfig.Deadline,
+		"attestation":          &c.AttestationConfig.Deadline,
+		"executionPayload":     &c.ExecutionPayloadConfig.Deadline,
+	}
+
+	for name, d := range deadlines {
+		if err := d.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid %s deriver config", name)
+		}
+
+		if d.SkipSlotsPastDeadline && c.SkippedSlotsPath == "" {
+			return errors.Errorf("invalid %s deriver config: skipSlotsPastDeadline requires skippedSlotsPath to be set", name)
+		}
+	}
+
+	for _, e := range c.eventConfigs() {
+		if err := e.batch.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid %s deriver config", e.name)
+		}
+
+		if err := e.dedup.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid %s deriver config", e.name)
+		}
+	}
+
+	slotRanges := map[string]*iterator.SlotRange{
+		"attesterSlashing":     &c.AttesterSlashingConfig.SlotRange,
+		"blsToExecutionChange": &c.BLSToExecutionConfig.SlotRange,
+		"deposit":              &c.DepositConfig.SlotRange,
+		"executionTransaction": &c.ExecutionTransactionConfig.SlotRange,
+		"proposerSlashing":     &c.ProposerSlashingConfig.SlotRange,
+		"voluntaryExit":        &c.VoluntaryExitConfig.SlotRange,
+		"withdrawal":           &c.WithdrawalConfig.SlotRange,
+		"beaconBlock":          &c.BeaconBlockConfig.SlotRange,
+		"beaconBlobSidecar":    &c.BeaconBlobSidecarConfig.SlotRange,
+		"syncAggregate":        &c.SyncAggregateConfig.SlotRange,
+		"blockSummary":         &c.BlockSummaryConfig.SlotRange,
+		"beaconBlockReward":    &c.BeaconBlockRewardConfig.SlotRange,
+		"attestation":          &c.AttestationConfig.SlotRange,
+		"executionPayload":     &c.ExecutionPayloadConfig.SlotRange,
+		"validatorActivity":    &c.ValidatorActivityConfig.SlotRange,
+		"proposerDuty":         &c.ProposerDutyConfig.SlotRange,
+		"beaconCommittee":      &c.BeaconCommitteeConfig.SlotRange,
+	}
+
+	for name, r := range slotRanges {
+		if err := r.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid %s deriver config", name)
+		}
+	}
+
+	if err := c.CircuitBreaker.Validate(); err != nil {
+		return errors.Wrap(err, "invalid circuit breaker config")
+	}
+
 	return nil
 }
+
+// eventConfig is the event batching and dedup config of a single deriver.
+type eventConfig struct {
+	name       string
+	cannonType xatu.CannonType
+	batch      *eventbatch.Config
+	dedup      *dedup.Config
+}
+
+func (c *Config) eventConfigs() []eventConfig {
+	return []eventConfig{
+		{"attesterSlashing", v2.AttesterSlashingDeriverName, &c.AttesterSlashingConfig.Batch, &c.AttesterSlashingConfig.Dedup},
+		{"blsToExecutionChange", v2.BLSToExecutionChangeDeriverName, &c.BLSToExecutionConfig.Batch, &c.BLSToExecutionConfig.Dedup},
+		{"deposit", v2.DepositDeriverName, &c.DepositConfig.Batch, &c.DepositConfig.Dedup},
+		{"executionTransaction", v2.ExecutionTransactionDeriverName, &c.ExecutionTransactionConfig.Batch, &c.ExecutionTransactionConfig.Dedup},
+		{"proposerSlashing", v2.ProposerSlashingDeriverName, &c.ProposerSlashingConfig.Batch, &c.ProposerSlashingConfig.Dedup},
+		{"voluntaryExit", v2.VoluntaryExitDeriverName, &c.VoluntaryExitConfig.Batch, &c.VoluntaryExitConfig.Dedup},
+		{"withdrawal", v2.WithdrawalDeriverName, &c.WithdrawalConfig.Batch, &c.WithdrawalConfig.Dedup},
+		{"beaconBlock", v2.BeaconBlockDeriverName, &c.BeaconBlockConfig.Batch, &c.BeaconBlockConfig.Dedup},
+		{"blockClassification", blockprint.BlockClassificationName, &c.BlockClassificationConfig.Batch, &c.BlockClassificationConfig.Dedup},
+		{"beaconBlobSidecar", v1.BeaconBlobDeriverName, &c.BeaconBlobSidecarConfig.Batch, &c.BeaconBlobSidecarConfig.Dedup},
+		{"syncAggregate", v2.SyncAggregateDeriverName, &c.SyncAggregateConfig.Batch, &c.SyncAggregateConfig.Dedup},
+		{"blockSummary", v2.BlockSummaryDeriverName, &c.BlockSummaryConfig.Batch, &c.BlockSummaryConfig.Dedup},
+		{"beaconBlockReward", v1.BeaconBlockRewardDeriverName, &c.BeaconBlockRewardConfig.Batch, &c.BeaconBlockRewardConfig.Dedup},
+		{"attestation", v2.AttestationDeriverName, &c.AttestationConfig.Batch, &c.AttestationConfig.Dedup},
+		{"executionPayload", v2.ExecutionPayloadDeriverName, &c.ExecutionPayloadConfig.Batch, &c.ExecutionPayloadConfig.Dedup},
+		{"validatorActivity", v1.ValidatorActivityDeriverName, &c.ValidatorActivityConfig.Batch, &c.ValidatorActivityConfig.Dedup},
+		{"lightClientUpdate", v1.LightClientUpdateDeriverName, &c.LightClientUpdateConfig.Batch, &c.LightClientUpdateConfig.Dedup},
+		{"proposerDuty", v1.ProposerDutyDeriverName, &c.ProposerDutyConfig.Batch, &c.ProposerDutyConfig.Dedup},
+		{"beaconCommittee", v1.BeaconCommitteeDeriverName, &c.BeaconCommitteeConfig.Batch, &c.BeaconCommitteeConfig.Dedup},
+	}
+}
+
+// WrapEventsCallback applies the batching and dedup config of the deriver with the cannon type to fn. Events
+// are deduplicated before they are split into batches.
+func (c *Config) WrapEventsCallback(cannonType xatu.CannonType, metrics *dedup.Metrics, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	for _, e := range c.eventConfigs() {
+		if e.cannonType == cannonType {
+			return eventbatch.Chunked(e.batch.MaxEventsPerBatch, dedup.Wrap(e.dedup, metrics, cannonType, fn))
+		}
+	}
+
+	return fn
+}
@@ -3,6 +3,7 @@ package deriver
 import (
 	"context"
 
+	v1 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v1"
 	v2 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v2"
 	"github.com/ethpandaops/xatu/pkg/cannon/deriver/blockprint"
 	"github.com/ethpandaops/xatu/pkg/proto/xatu"
@@ -15,6 +16,7 @@ type EventDeriver interface {
 	CannonType() xatu.CannonType
 	// Callbacks
 	OnEventsDerived(ctx context.Context, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error)
+	OnLocationUpdated(ctx context.Context, fn func(ctx context.Context, location uint64) error)
 }
 
 // Ensure that derivers implements the EventDeriver interface
@@ -26,4 +28,16 @@ var _ EventDeriver = &v2.ExecutionTransactionDeriver{}
 var _ EventDeriver = &v2.BLSToExecutionChangeDeriver{}
 var _ EventDeriver = &v2.WithdrawalDeriver{}
 var _ EventDeriver = &v2.BeaconBlockDeriver{}
+var _ EventDeriver = &v2.SyncAggregateDeriver{}
 var _ EventDeriver = &blockprint.BlockClassificationDeriver{}
+var _ EventDeriver = &v2.BlockSummaryDeriver{}
+var _ EventDeriver = &v1.BeaconBlobDeriver{}
+var _ EventDeriver = &v1.BeaconBlockRewardDeriver{}
+var _ EventDeriver = &v2.AttestationDeriver{}
+var _ EventDeriver = &v2.ExecutionPayloadDeriver{}
+var _ EventDeriver = &v1.ValidatorActivityDeriver{}
+var _ EventDeriver = &v1.LightClientUpdateDeriver{}
+var _ EventDeriver = &v1.ProposerDutyDeriver{}
+var _ EventDeriver = &v1.BeaconCommitteeDeriver{}
+
+var _ NodeVersionRequirer = &v1.BeaconBlobDeriver{}
@@ -0,0 +1,45 @@
+package deriver
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/xatu/pkg/cannon/ethereum/services"
+)
+
+// NodeVersionRequirer is implemented by derivers that rely on beacon node API features only available from a
+// given release of each client. Clients missing from the map are not checked.
+type NodeVersionRequirer interface {
+	MinNodeVersions() map[services.Client]string
+}
+
+// CheckNodeVersion returns an error if the deriver declares a minimum version for the beacon node's client
+// and the node is older than it. Node versions that can't be parsed are let through.
+func CheckNodeVersion(d EventDeriver, nodeVersion string) error {
+	requirer, ok := d.(NodeVersionRequirer)
+	if !ok {
+		return nil
+	}
+
+	client := services.ClientFromString(nodeVersion)
+
+	required, ok := requirer.MinNodeVersions()[client]
+	if !ok {
+		return nil
+	}
+
+	minimum, err := services.ParseVersion(required)
+	if err != nil {
+		return fmt.Errorf("invalid minimum %s version for %s: %w", client, d.Name(), err)
+	}
+
+	actual, err := services.ParseVersion(nodeVersion)
+	if err != nil {
+		return nil
+	}
+
+	if actual.LessThan(minimum) {
+		return fmt.Errorf("%s requires %s %s or newer but the beacon node is running %s", d.Name(), client, minimum, actual)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package deriver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+)
+
+// CheckUniqueCannonTypes returns an error if more than one deriver has the same CannonType. Locations are stored
+// in the coordinator by CannonType, so derivers sharing one would overwrite each other's progress.
+func CheckUniqueCannonTypes(derivers []EventDeriver) error {
+	names := make(map[xatu.CannonType][]string, len(derivers))
+	order := []xatu.CannonType{}
+
+	for _, d := range derivers {
+		if _, ok := names[d.CannonType()]; !ok {
+			order = append(order, d.CannonType())
+		}
+
+		names[d.CannonType()] = append(names[d.CannonType()], d.Name())
+	}
+
+	duplicates := []string{}
+
+	for _, cannonType := range order {
+		if len(names[cannonType]) < 2 {
+			continue
+		}
+
+		duplicates = append(duplicates, fmt.Sprintf("%s (%s)", cannonType, strings.Join(names[cannonType], ", ")))
+	}
+
+	if len(duplicates) > 0 {
+		return fmt.Errorf("derivers share a cannon type: %s", strings.Join(duplicates, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package cannon
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type driftResponse struct {
+	ClockDriftMs int64      `json:"clockDriftMs"`
+	LastSync     *time.Time `json:"lastSync,omitempty"`
+	Server       string     `json:"server,omitempty"`
+	Disabled     bool       `json:"disabled,omitempty"`
+}
+
+func (c *Cannon) setClockDrift(drift time.Duration, server string) {
+	c.clockDriftMu.Lock()
+	defer c.clockDriftMu.Unlock()
+
+	c.clockDrift = drift
+	c.clockDriftServer = server
+	c.clockDriftSyncedAt = time.Now()
+}
+
+func (c *Cannon) getClockDrift() time.Duration {
+	c.clockDriftMu.RLock()
+	defer c.clockDriftMu.RUnlock()
+
+	return c.clockDrift
+}
+
+// handleDrift reports the clock drift measured against the NTP servers. lastSync and server are omitted until
+// the first successful sync, and disabled is set when NTP is turned off.
+func (c *Cannon) handleDrift(w http.ResponseWriter, r *http.Request) {
+	c.clockDriftMu.RLock()
+
+	response := driftResponse{
+		ClockDriftMs: c.clockDrift.Milliseconds(),
+		Server:       c.clockDriftServer,
+		Disabled:     c.Config.NTPDisabled,
+	}
+
+	if !c.clockDriftSyncedAt.IsZero() {
+		syncedAt := c.clockDriftSyncedAt.UTC()
+		response.LastSync = &syncedAt
+	}
+
+	c.clockDriftMu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		c.log.WithError(err).Debug("Failed to write drift response")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/ethpandaops/beacon/pkg/beacon"
 	"github.com/ethpandaops/xatu/pkg/cannon/ethereum/services"
 	"github.com/ethpandaops/xatu/pkg/networks"
@@ -14,6 +15,7 @@ import (
 	"github.com/go-co-op/gocron"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -25,8 +27,14 @@ type BeaconNode struct {
 	config *Config
 	log    logrus.FieldLogger
 
-	beacon  beacon.Node
+	upstreams  []*upstream
+	activeMu   sync.RWMutex
+	active     int
+	failures   int
+	failoverMu sync.Mutex
+
 	metrics *Metrics
+	status  *statusTracker
 
 	services []services.Service
 
@@ -38,9 +46,7 @@ type BeaconNode struct {
 	blockPreloadSem  chan struct{}
 }
 
-func NewBeaconNode(ctx context.Context, name string, config *Config, log logrus.FieldLogger) (*BeaconNode, error) {
-	namespace := "xatu_cannon"
-
+func NewBeaconNode(ctx context.Context, name, namespace string, registerer prometheus.Registerer, config *Config, log logrus.FieldLogger) (*BeaconNode, error) {
 	opts := *beacon.
 		DefaultOptions().
 		DisableEmptySlotDetection().
@@ -51,30 +57,33 @@ func NewBeaconNode(ctx context.Context, name string, config *Config, log logrus.
 
 	opts.BeaconSubscription.Enabled = false
 
-	node := beacon.NewNode(log, &beacon.Config{
-		Name:    name,
-		Addr:    config.BeaconNodeAddress,
-		Headers: config.BeaconNodeHeaders,
-	}, namespace, opts)
+	upstreams := []*upstream{}
 
-	metadata := services.NewMetadataService(log, node)
-
-	if config.OverrideNetworkName != "" {
-		metadata.OverrideNetworkName(config.OverrideNetworkName)
-	}
+	for i, address := range config.Addresses() {
+		nodeName := name
+		if i > 0 {
+			nodeName = fmt.Sprintf("%s-fallback-%d", name, i)
+		}
 
-	svcs := []services.Service{
-		&metadata,
+		upstreams = append(upstreams, &upstream{
+			address: address,
+			node: beacon.NewNode(log, &beacon.Config{
+				Name:    nodeName,
+				Addr:    address,
+				Headers: config.BeaconNodeHeaders,
+			}, namespace, opts),
+		})
 	}
 
 	// Create a buffered channel (semaphore) to limit the number of concurrent goroutines.
 	sem := make(chan struct{}, config.BlockPreloadWorkers)
 
-	return &BeaconNode{
-		config:   config,
-		log:      log.WithField("module", "cannon/ethereum/beacon"),
-		beacon:   node,
-		services: svcs,
+	metrics := NewMetrics(namespace, name, registerer)
+
+	b := &BeaconNode{
+		config:    config,
+		log:       log.WithField("module", "cannon/ethereum/beacon"),
+		upstreams: upstreams,
 		blockCache: ttlcache.New(
 			ttlcache.WithTTL[string, *spec.VersionedSignedBeaconBlock](config.BlockCacheTTL.Duration),
 			ttlcache.WithCapacity[string, *spec.VersionedSignedBeaconBlock](config.BlockCacheSize),
@@ -82,8 +91,25 @@ func NewBeaconNode(ctx context.Context, name string, config *Config, log logrus.
 		sfGroup:          &singleflight.Group{},
 		blockPreloadChan: make(chan string, config.BlockPreloadQueueSize),
 		blockPreloadSem:  sem,
-		metrics:          NewMetrics(namespace, name),
-	}, nil
+		metrics:          metrics,
+		status:           newStatusTracker(metrics),
+	}
+
+	metadata := services.NewMetadataService(log, b.Node)
+
+	if config.OverrideNetworkName != "" {
+		metadata.OverrideNetworkName(config.OverrideNetworkName)
+	}
+
+	if config.OverrideNetworkID != nil {
+		metadata.OverrideNetworkID(*config.OverrideNetworkID)
+	}
+
+	b.services = []services.Service{
+		&metadata,
+	}
+
+	return b, nil
 }
 
 func (b *BeaconNode) Start(ctx context.Context) error {
@@ -127,10 +153,21 @@ func (b *BeaconNode) Start(ctx context.Context) error {
 		}
 	}()
 
+	if err := b.scheduleKeepAlive(ctx, s); err != nil {
+		return err
+	}
+
 	s.StartAsync()
 
-	if err := b.beacon.Start(ctx); err != nil {
-		return err
+	for _, u := range b.upstreams {
+		u := u
+
+		b.status.subscribe(ctx, u.node, func() bool { return b.isActive(u) })
+		b.subscribeHealth(ctx, u)
+
+		if err := u.node.Start(ctx); err != nil {
+			return err
+		}
 	}
 
 	b.blockCache.OnEviction(func(ctx context.Context, reason ttlcache.EvictionReason, item *ttlcache.Item[string, *spec.VersionedSignedBeaconBlock]) {
@@ -160,8 +197,10 @@ func (b *BeaconNode) Start(ctx context.Context) error {
 	}
 }
 
+// Node returns the beacon node that is currently serving requests. This can change on failover, so the
+// node should not be held on to.
 func (b *BeaconNode) Node() beacon.Node {
-	return b.beacon
+	return b.activeUpstream().node
 }
 
 func (b *BeaconNode) getServiceByName(name services.Name) (services.Service, error) {
@@ -188,8 +227,13 @@ func (b *BeaconNode) OnReady(_ context.Context, callback func(ctx context.Contex
 	b.onReadyCallbacks = append(b.onReadyCallbacks, callback)
 }
 
+// Status returns the current connection status of the beacon node.
+func (b *BeaconNode) Status() BeaconStatus {
+	return b.status.Status()
+}
+
 func (b *BeaconNode) Synced(ctx context.Context) error {
-	status := b.beacon.Status()
+	status := b.Node().Status()
 	if status == nil {
 		return errors.New("missing beacon status")
 	}
@@ -223,7 +267,19 @@ func (b *BeaconNode) Synced(ctx context.Context) error {
 	return nil
 }
 
+// WithRequestTimeout bounds the context of a beacon node request by the given timeout. A zero timeout leaves
+// the context untouched so the beacon node client's own timeout applies.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
 // GetBeaconBlock returns a beacon block by its identifier. Blocks can be cached internally.
+// ErrSlotSkipped is returned if there is no block at the identifier, and beacon node failures are
+// classified as ErrNodeUnavailable or ErrBlockPruned where possible.
 func (b *BeaconNode) GetBeaconBlock(ctx context.Context, identifier string, ignoreMetrics ...bool) (*spec.VersionedSignedBeaconBlock, error) {
 	ctx, span := observability.Tracer().Start(ctx, "ethereum.beacon.GetBeaconBlock", trace.WithAttributes(attribute.String("identifier", identifier)))
 
@@ -239,6 +295,10 @@ func (b *BeaconNode) GetBeaconBlock(ctx context.Context, identifier string, igno
 
 		span.SetAttributes(attribute.Bool("cached", true))
 
+		if item.Value() == nil {
+			return nil, ErrSlotSkipped
+		}
+
 		return item.Value(), nil
 	}
 
@@ -248,8 +308,12 @@ func (b *BeaconNode) GetBeaconBlock(ctx context.Context, identifier string, igno
 		b.metrics.IncBlockCacheMiss(string(b.Metadata().Network.Name))
 	}
 
-	// Use singleflight to ensure we only make one request for a block at a time.
-	x, err, shared := b.sfGroup.Do(identifier, func() (interface{}, error) {
+	// Use singleflight to ensure we only make one request for a block at a time. The request is detached from
+	// the caller's context so one caller's requestTimeout can't cancel the request for everyone else waiting on
+	// it; the beacon node client's own timeout still bounds it.
+	fetchCtx := trace.ContextWithSpan(context.Background(), span)
+
+	ch := b.sfGroup.DoChan(identifier, func() (interface{}, error) {
 		span.AddEvent("Acquiring semaphore...")
 
 		// Acquire a semaphore before proceeding.
@@ -259,18 +323,44 @@ func (b *BeaconNode) GetBeaconBlock(ctx context.Context, identifier string, igno
 		span.AddEvent("Semaphore acquired. Fetching block from beacon api...")
 
 		// Not in the cache, so fetch it.
-		block, err := b.beacon.FetchBlock(ctx, identifier)
+		start := time.Now()
+
+		block, err := b.Node().FetchBlock(fetchCtx, identifier)
+
+		b.observeBlockFetch(BlockFetchTypeBlock, start)
+
+		err = ClassifyError(err)
+
+		b.recordResult(fetchCtx, err)
+
 		if err != nil {
 			return nil, err
 		}
 
 		span.AddEvent("Block fetched from beacon node.")
 
+		// Only counted on fetch as skipped slots are cached like any other block.
+		if block == nil {
+			b.metrics.IncSkippedSlots(string(b.Metadata().Network.Name))
+		}
+
 		// Add it to the cache.
 		b.blockCache.Set(identifier, block, time.Hour)
 
 		return block, nil
 	})
+
+	var result singleflight.Result
+
+	select {
+	case result = <-ch:
+	case <-ctx.Done():
+		// The caller gave up waiting, which says nothing about the beacon node, so it isn't counted towards
+		// failover. The request carries on for any other callers.
+		result.Err = ClassifyError(ctx.Err())
+	}
+
+	x, err, shared := result.Val, result.Err, result.Shared
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 
@@ -283,7 +373,27 @@ func (b *BeaconNode) GetBeaconBlock(ctx context.Context, identifier string, igno
 
 	span.AddEvent("Block fetching complete.", trace.WithAttributes(attribute.Bool("shared", shared)))
 
-	return x.(*spec.VersionedSignedBeaconBlock), nil
+	block, ok := x.(*spec.VersionedSignedBeaconBlock)
+	if !ok || block == nil {
+		return nil, ErrSlotSkipped
+	}
+
+	return block, nil
+}
+
+// GetBlobSidecars returns the blob sidecars for the block at the identifier. Unlike blocks, sidecars are not cached.
+func (b *BeaconNode) GetBlobSidecars(ctx context.Context, identifier string) ([]*deneb.BlobSidecar, error) {
+	start := time.Now()
+
+	blobs, err := b.Node().FetchBeaconBlockBlobs(ctx, identifier)
+
+	b.observeBlockFetch(BlockFetchTypeBlobSidecar, start)
+
+	return blobs, err
+}
+
+func (b *BeaconNode) observeBlockFetch(fetchType BlockFetchType, start time.Time) {
+	b.metrics.ObserveBlockFetchDuration(string(b.Metadata().Network.Name), fetchType, time.Since(start))
 }
 
 func (b *BeaconNode) LazyLoadBeaconBlock(identifier string) {
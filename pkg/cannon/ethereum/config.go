@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/ethpandaops/beacon/pkg/human"
 )
@@ -9,9 +10,19 @@ import (
 type Config struct {
 	// The address of the Beacon node to connect to
 	BeaconNodeAddress string `yaml:"beaconNodeAddress"`
+	// BeaconNodeAddresses are further beacon nodes to fail over to, in order of preference.
+	BeaconNodeAddresses []string `yaml:"beaconNodeAddresses"`
+	// FailoverThreshold is the number of consecutive errors from the active beacon node before failing over.
+	FailoverThreshold int `yaml:"failoverThreshold" default:"3"`
 	// OverrideNetworkName is the name of the network to use for the sentry.
 	// If not set, the network name will be retrieved from the beacon node.
 	OverrideNetworkName string `yaml:"overrideNetworkName"  default:""`
+	// OverrideNetworkID is the network ID to use instead of the deposit chain ID reported by the beacon node.
+	// Independent of OverrideNetworkName.
+	OverrideNetworkID *uint64 `yaml:"overrideNetworkId"`
+	// ExpectedNetworkName is the network the beacon node must report. The derivers are not started
+	// if the beacon node is on a different network. Can not be combined with OverrideNetworkName.
+	ExpectedNetworkName string `yaml:"expectedNetworkName" default:""`
 	// BlockCacheSize is the number of blocks to cache.
 	BlockCacheSize uint64 `yaml:"blockCacheSize" default:"1000"`
 	// BlockCacheTTL is the time to live for blocks in the cache.
@@ -22,12 +33,48 @@ type Config struct {
 	BlockPreloadWorkers uint64 `yaml:"blockPreloadWorkers" default:"5"`
 	// BlockPreloadQueueSize is the size of the queue for preloading blocks.
 	BlockPreloadQueueSize uint64 `yaml:"blockPreloadQueueSize" default:"5000"`
+	// KeepAlive pings the beacon nodes to keep their connections open while idle.
+	KeepAlive KeepAliveConfig `yaml:"keepAlive"`
 }
 
 func (c *Config) Validate() error {
-	if c.BeaconNodeAddress == "" {
-		return errors.New("beaconNodeAddress is required")
+	if len(c.Addresses()) == 0 {
+		return errors.New("beaconNodeAddress or beaconNodeAddresses is required")
+	}
+
+	if c.FailoverThreshold < 1 {
+		return errors.New("failoverThreshold must be at least 1")
+	}
+
+	if c.ExpectedNetworkName != "" && c.OverrideNetworkName != "" {
+		return errors.New("expectedNetworkName and overrideNetworkName can not be used together")
+	}
+
+	if c.OverrideNetworkID != nil && *c.OverrideNetworkID == 0 {
+		return errors.New("overrideNetworkId must be greater than 0")
+	}
+
+	if err := c.KeepAlive.Validate(); err != nil {
+		return fmt.Errorf("invalid keepAlive config: %w", err)
 	}
 
 	return nil
 }
+
+// Addresses returns the beacon node addresses in order of preference.
+func (c *Config) Addresses() []string {
+	addresses := []string{}
+	seen := map[string]bool{}
+
+	for _, address := range append([]string{c.BeaconNodeAddress}, c.BeaconNodeAddresses...) {
+		if address == "" || seen[address] {
+			continue
+		}
+
+		seen[address] = true
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
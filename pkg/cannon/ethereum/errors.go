@@ -0,0 +1,78 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrSlotSkipped is returned when there is no block at the requested slot.
+	ErrSlotSkipped = errors.New("slot skipped")
+	// ErrNodeUnavailable is returned when the beacon node could not be reached or failed to serve the request.
+	// These failures are transient and the request should be retried later.
+	ErrNodeUnavailable = errors.New("beacon node unavailable")
+	// ErrBlockPruned is returned when the beacon node no longer holds the requested block.
+	ErrBlockPruned = errors.New("block pruned by beacon node")
+)
+
+var serverErrorStatus = regexp.MustCompile(`status (code )?5\d\d`)
+
+// beaconError tags an error from the beacon node with one of the sentinel errors above while keeping the original.
+type beaconError struct {
+	kind error
+	err  error
+}
+
+func (e *beaconError) Error() string {
+	return fmt.Sprintf("%s: %s", e.kind, e.err)
+}
+
+func (e *beaconError) Unwrap() error {
+	return e.err
+}
+
+func (e *beaconError) Is(target error) bool {
+	return target == e.kind
+}
+
+// NewNodeUnavailableError marks err as a transient beacon node failure.
+func NewNodeUnavailableError(err error) error {
+	return &beaconError{kind: ErrNodeUnavailable, err: err}
+}
+
+// ClassifyError maps an error returned by the beacon node client onto the sentinel errors. Errors that can't be
+// classified are returned as is.
+func ClassifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, ErrSlotSkipped) || errors.Is(err, ErrNodeUnavailable) || errors.Is(err, ErrBlockPruned) {
+		return err
+	}
+
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr):
+		return NewNodeUnavailableError(err)
+	}
+
+	msg := strings.ToLower(err.Error())
+
+	switch {
+	case strings.Contains(msg, "prune"):
+		return &beaconError{kind: ErrBlockPruned, err: err}
+	case serverErrorStatus.MatchString(msg),
+		strings.Contains(msg, "connection refused"),
+		strings.Contains(msg, "connection reset"),
+		strings.Contains(msg, "eof"):
+		return NewNodeUnavailableError(err)
+	}
+
+	return err
+}
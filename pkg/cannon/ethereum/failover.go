@@ -0,0 +1,170 @@
+package ethereum
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethpandaops/beacon/pkg/beacon"
+	"github.com/pkg/errors"
+)
+
+// upstream is one of the configured beacon nodes.
+type upstream struct {
+	address string
+	node    beacon.Node
+
+	mu      sync.Mutex
+	healthy bool
+}
+
+func (u *upstream) setHealthy(healthy bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.healthy = healthy
+}
+
+func (u *upstream) isHealthy() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.healthy
+}
+
+func (b *BeaconNode) activeUpstream() *upstream {
+	b.activeMu.RLock()
+	defer b.activeMu.RUnlock()
+
+	return b.upstreams[b.active]
+}
+
+// activeAddress returns the address of the beacon node that is currently serving requests.
+func (b *BeaconNode) activeAddress() string {
+	return b.activeUpstream().address
+}
+
+func (b *BeaconNode) isActive(u *upstream) bool {
+	return b.activeUpstream() == u
+}
+
+// subscribeHealth tracks the health of every upstream so a healthy one can be picked on failover.
+func (b *BeaconNode) subscribeHealth(ctx context.Context, u *upstream) {
+	u.node.OnHealthCheckFailed(ctx, func(ctx context.Context, event *beacon.HealthCheckFailedEvent) error {
+		u.setHealthy(false)
+
+		if b.isActive(u) {
+			b.recordResult(ctx, NewNodeUnavailableError(errors.New("health check failed")))
+		}
+
+		return nil
+	})
+
+	u.node.OnHealthCheckSucceeded(ctx, func(ctx context.Context, event *beacon.HealthCheckSucceededEvent) error {
+		u.setHealthy(true)
+
+		return nil
+	})
+}
+
+// recordResult counts consecutive node unavailable errors of the active beacon node, and fails over to the
+// next healthy beacon node once FailoverThreshold is reached. Other errors are the node answering, so they
+// reset the count.
+func (b *BeaconNode) recordResult(ctx context.Context, err error) {
+	if len(b.upstreams) < 2 {
+		return
+	}
+
+	b.activeMu.Lock()
+
+	if err == nil || !errors.Is(err, ErrNodeUnavailable) {
+		b.failures = 0
+
+		b.activeMu.Unlock()
+
+		return
+	}
+
+	b.failures++
+
+	if b.failures < b.config.FailoverThreshold {
+		b.activeMu.Unlock()
+
+		return
+	}
+
+	b.failures = 0
+	current := b.active
+
+	b.activeMu.Unlock()
+
+	b.failover(ctx, current)
+}
+
+// failover switches to the next healthy beacon node after the current one. The candidate must be on the same
+// chain as the node it replaces, so the metadata and already derived locations remain valid. OnReady callbacks
+// are not re-run as the derivers are already running.
+func (b *BeaconNode) failover(ctx context.Context, from int) {
+	b.failoverMu.Lock()
+	defer b.failoverMu.Unlock()
+
+	// Another caller may have already failed over.
+	b.activeMu.RLock()
+	if b.active != from {
+		b.activeMu.RUnlock()
+
+		return
+	}
+	b.activeMu.RUnlock()
+
+	log := b.log.WithField("from", b.upstreams[from].address)
+
+	for i := 1; i < len(b.upstreams); i++ {
+		index := (from + i) % len(b.upstreams)
+		candidate := b.upstreams[index]
+
+		if !candidate.isHealthy() {
+			continue
+		}
+
+		if err := b.sameChain(candidate); err != nil {
+			log.WithError(err).WithField("candidate", candidate.address).Warn("Not failing over to beacon node")
+
+			continue
+		}
+
+		b.activeMu.Lock()
+		b.active = index
+		b.failures = 0
+		b.activeMu.Unlock()
+
+		b.metrics.IncFailovers(string(b.Metadata().Network.Name))
+
+		log.WithField("to", candidate.address).Warn("Failed over to another beacon node")
+
+		if err := b.Metadata().RefreshAll(ctx); err != nil {
+			log.WithError(err).Warn("Failed to refresh metadata after failover")
+		}
+
+		return
+	}
+
+	log.Warn("Beacon node is unavailable but no healthy beacon node is available to fail over to")
+}
+
+func (b *BeaconNode) sameChain(candidate *upstream) error {
+	current := b.Metadata().Genesis
+	if current == nil {
+		return nil
+	}
+
+	genesis, err := candidate.node.Genesis()
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch genesis")
+	}
+
+	if genesis == nil || genesis.GenesisValidatorsRoot != current.GenesisValidatorsRoot {
+		return errors.New("beacon node is on a different network")
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/go-co-op/gocron"
+)
+
+type KeepAliveConfig struct {
+	// Enabled periodically pings the beacon nodes so idle connections are kept open, and dead ones are
+	// replaced, before the next fetch needs them.
+	Enabled bool `yaml:"enabled" default:"false"`
+	// Interval is how often the beacon nodes are pinged. Should be shorter than the idle timeout of any load
+	// balancer in front of the beacon nodes.
+	Interval human.Duration `yaml:"interval" default:"30s"`
+	// Timeout bounds each ping, so a dropped connection is noticed rather than waited on.
+	Timeout human.Duration `yaml:"timeout" default:"5s"`
+	// Connections is the number of concurrent pings sent to each beacon node, which is how many pooled
+	// connections are kept warm.
+	Connections int `yaml:"connections" default:"5"`
+}
+
+func (c *KeepAliveConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Interval.Duration <= 0 {
+		return errors.New("interval must be greater than 0")
+	}
+
+	if c.Timeout.Duration <= 0 || c.Timeout.Duration >= c.Interval.Duration {
+		return errors.New("timeout must be greater than 0 and less than interval")
+	}
+
+	if c.Connections < 1 {
+		return errors.New("connections must be at least 1")
+	}
+
+	return nil
+}
+
+func (b *BeaconNode) scheduleKeepAlive(ctx context.Context, s *gocron.Scheduler) error {
+	if !b.config.KeepAlive.Enabled {
+		return nil
+	}
+
+	_, err := s.Every(b.config.KeepAlive.Interval.Duration).Do(func() {
+		for _, u := range b.upstreams {
+			b.keepAlive(ctx, u)
+		}
+	})
+
+	return err
+}
+
+// keepAlive pings the upstream over as many connections as configured. The HTTP transport discards connections
+// that fail, so later fetches dial fresh ones instead of stalling on a connection a load balancer has dropped.
+func (b *BeaconNode) keepAlive(ctx context.Context, u *upstream) {
+	ctx, cancel := context.WithTimeout(ctx, b.config.KeepAlive.Timeout.Duration)
+	defer cancel()
+
+	errs := make(chan error, b.config.KeepAlive.Connections)
+
+	var wg sync.WaitGroup
+
+	start := time.Now()
+
+	for i := 0; i < b.config.KeepAlive.Connections; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := u.node.FetchNodeVersion(ctx); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	failed := len(errs)
+	if failed == 0 {
+		return
+	}
+
+	err := <-errs
+
+	b.metrics.AddKeepAliveFailures(string(b.Metadata().Network.Name), failed)
+
+	b.log.
+		WithError(err).
+		WithField("address", u.address).
+		WithField("failed", failed).
+		WithField("took", time.Since(start)).
+		Warn("Beacon node keep-alive ping failed")
+
+	if b.isActive(u) {
+		b.recordResult(ctx, ClassifyError(err))
+	}
+}
@@ -0,0 +1,178 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrLightClientUnsupported is returned when the beacon node does not serve light client updates, e.g. Lighthouse
+// without --light-client-server.
+var ErrLightClientUnsupported = errors.New("beacon node does not serve light client updates (/eth/v1/beacon/light_client)")
+
+// ErrLightClientUpdateNotFound is returned when the beacon node has no light client update available yet.
+var ErrLightClientUpdateNotFound = errors.New("light client update not found")
+
+type LightClientUpdateType string
+
+const (
+	LightClientUpdateTypeFinality   LightClientUpdateType = "finality"
+	LightClientUpdateTypeOptimistic LightClientUpdateType = "optimistic"
+)
+
+// LightClientHeader is the beacon block header carried by a light client update.
+type LightClientHeader struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    string
+	StateRoot     string
+	BodyRoot      string
+}
+
+// LightClientUpdate is the latest finality or optimistic update served by the beacon node.
+type LightClientUpdate struct {
+	Type           LightClientUpdateType
+	AttestedHeader LightClientHeader
+	// FinalizedHeader is only set on finality updates.
+	FinalizedHeader        *LightClientHeader
+	SyncCommitteeBits      string
+	SyncCommitteeSignature string
+	SignatureSlot          uint64
+}
+
+type lightClientBeaconHeader struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+// lightClientHeaderResponse accepts both the current {"beacon": {...}} header layout and the bare header some
+// clients served before Capella.
+type lightClientHeaderResponse struct {
+	Beacon *lightClientBeaconHeader `json:"beacon"`
+	lightClientBeaconHeader
+}
+
+type lightClientUpdateResponse struct {
+	Data struct {
+		AttestedHeader  lightClientHeaderResponse  `json:"attested_header"`
+		FinalizedHeader *lightClientHeaderResponse `json:"finalized_header"`
+		SyncAggregate   struct {
+			SyncCommitteeBits      string `json:"sync_committee_bits"`
+			SyncCommitteeSignature string `json:"sync_committee_signature"`
+		} `json:"sync_aggregate"`
+		SignatureSlot string `json:"signature_slot"`
+	} `json:"data"`
+}
+
+// GetLightClientUpdate fetches the latest finality or optimistic update from the beacon node's
+// /eth/v1/beacon/light_client/{finality,optimistic}_update endpoint.
+func (b *BeaconNode) GetLightClientUpdate(ctx context.Context, updateType LightClientUpdateType) (*LightClientUpdate, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/light_client/%s_update", strings.TrimSuffix(b.activeAddress(), "/"), updateType)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range b.config.BeaconNodeHeaders {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	start := time.Now()
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		b.observeBlockFetch(BlockFetchTypeLightClient, start)
+
+		return nil, errors.Wrapf(err, "failed to request light client %s update", updateType)
+	}
+
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+
+	b.observeBlockFetch(BlockFetchTypeLightClient, start)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read light client %s update response", updateType)
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrLightClientUpdateNotFound
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, ErrLightClientUnsupported
+	default:
+		return nil, fmt.Errorf("unexpected status code %d fetching light client %s update: %s", rsp.StatusCode, updateType, string(body))
+	}
+
+	var parsed lightClientUpdateResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode light client %s update response", updateType)
+	}
+
+	update := &LightClientUpdate{
+		Type:                   updateType,
+		SyncCommitteeBits:      parsed.Data.SyncAggregate.SyncCommitteeBits,
+		SyncCommitteeSignature: parsed.Data.SyncAggregate.SyncCommitteeSignature,
+	}
+
+	if update.SignatureSlot, err = strconv.ParseUint(parsed.Data.SignatureSlot, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "failed to parse light client update signature_slot")
+	}
+
+	attested, err := parsed.Data.AttestedHeader.toHeader()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse light client update attested_header")
+	}
+
+	update.AttestedHeader = *attested
+
+	if updateType == LightClientUpdateTypeFinality && parsed.Data.FinalizedHeader != nil {
+		finalized, err := parsed.Data.FinalizedHeader.toHeader()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse light client update finalized_header")
+		}
+
+		update.FinalizedHeader = finalized
+	}
+
+	return update, nil
+}
+
+func (h *lightClientHeaderResponse) toHeader() (*LightClientHeader, error) {
+	raw := h.lightClientBeaconHeader
+	if h.Beacon != nil {
+		raw = *h.Beacon
+	}
+
+	slot, err := strconv.ParseUint(raw.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slot")
+	}
+
+	proposerIndex, err := strconv.ParseUint(raw.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proposer_index")
+	}
+
+	return &LightClientHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    raw.ParentRoot,
+		StateRoot:     raw.StateRoot,
+		BodyRoot:      raw.BodyRoot,
+	}, nil
+}
@@ -1,6 +1,21 @@
 package ethereum
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BlockFetchType is the kind of block data requested from the beacon node.
+type BlockFetchType string
+
+const (
+	BlockFetchTypeBlock       BlockFetchType = "block"
+	BlockFetchTypeBlobSidecar BlockFetchType = "blob_sidecar"
+	BlockFetchTypeRewards     BlockFetchType = "rewards"
+	BlockFetchTypeLightClient BlockFetchType = "light_client"
+	BlockFetchTypeState       BlockFetchType = "state"
+)
 
 type Metrics struct {
 	beacon string
@@ -14,9 +29,23 @@ type Metrics struct {
 	blockCacheMiss *prometheus.CounterVec
 	// PreloadBlockQueueSize is the number of blocks in the preload queue.
 	preloadBlockQueueSize *prometheus.GaugeVec
+	// BeaconStatus is 1 for the current connection status of the beacon node and 0 for all others.
+	beaconStatus *prometheus.GaugeVec
+	// InvalidSlashings is the number of slashings that failed verification.
+	invalidSlashings *prometheus.CounterVec
+	// Failovers is the number of times cannon failed over to another beacon node.
+	failovers *prometheus.CounterVec
+	// BlockFetchDuration is the latency of block data requests made to the beacon node.
+	blockFetchDuration *prometheus.HistogramVec
+	// SkippedSlots is the number of slots the beacon node reported no block for.
+	skippedSlots *prometheus.CounterVec
+	// KeepAliveFailures is the number of keep-alive pings to the beacon nodes that failed.
+	keepAliveFailures *prometheus.CounterVec
 }
 
-func NewMetrics(namespace, beaconNodeName string) *Metrics {
+func NewMetrics(namespace, beaconNodeName string, registerer prometheus.Registerer) *Metrics {
+	rootNamespace := namespace
+
 	namespace += "_ethereum"
 
 	m := &Metrics{
@@ -46,13 +75,50 @@ func NewMetrics(namespace, beaconNodeName string) *Metrics {
 			Name:      "preload_block_queue_size",
 			Help:      "The number of blocks in the preload queue",
 		}, []string{"network", "beacon"}),
+		beaconStatus: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: rootNamespace,
+			Name:      "beacon_status",
+			Help:      "The connection status of the beacon node",
+		}, []string{"status", "beacon"}),
+		invalidSlashings: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "invalid_slashings_total",
+			Help:      "The number of slashings that failed verification",
+		}, []string{"network", "beacon", "type"}),
+		failovers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "beacon_failovers_total",
+			Help:      "The number of times cannon failed over to another beacon node",
+		}, []string{"network", "beacon"}),
+		blockFetchDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: rootNamespace,
+			Name:      "block_fetch_duration_seconds",
+			Help:      "The time taken to fetch block data from the beacon node",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+		}, []string{"network", "beacon", "type"}),
+		skippedSlots: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: rootNamespace,
+			Name:      "skipped_slots_total",
+			Help:      "The number of slots the beacon node reported no block for",
+		}, []string{"network"}),
+		keepAliveFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "keep_alive_failures_total",
+			Help:      "The number of keep-alive pings to the beacon nodes that failed",
+		}, []string{"network", "beacon"}),
 	}
 
-	prometheus.MustRegister(m.blocksFetched)
-	prometheus.MustRegister(m.blocksFetchErrors)
-	prometheus.MustRegister(m.blockCacheHit)
-	prometheus.MustRegister(m.blockCacheMiss)
-	prometheus.MustRegister(m.preloadBlockQueueSize)
+	registerer.MustRegister(m.blocksFetched)
+	registerer.MustRegister(m.blocksFetchErrors)
+	registerer.MustRegister(m.blockCacheHit)
+	registerer.MustRegister(m.blockCacheMiss)
+	registerer.MustRegister(m.preloadBlockQueueSize)
+	registerer.MustRegister(m.beaconStatus)
+	registerer.MustRegister(m.invalidSlashings)
+	registerer.MustRegister(m.failovers)
+	registerer.MustRegister(m.blockFetchDuration)
+	registerer.MustRegister(m.skippedSlots)
+	registerer.MustRegister(m.keepAliveFailures)
 
 	return m
 }
@@ -76,3 +142,34 @@ func (m *Metrics) IncBlockCacheMiss(network string) {
 func (m *Metrics) SetPreloadBlockQueueSize(network string, size int) {
 	m.preloadBlockQueueSize.WithLabelValues(network, m.beacon).Set(float64(size))
 }
+
+func (m *Metrics) IncInvalidSlashings(network, slashingType string) {
+	m.invalidSlashings.WithLabelValues(network, m.beacon, slashingType).Inc()
+}
+
+func (m *Metrics) IncSkippedSlots(network string) {
+	m.skippedSlots.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) AddKeepAliveFailures(network string, count int) {
+	m.keepAliveFailures.WithLabelValues(network, m.beacon).Add(float64(count))
+}
+
+func (m *Metrics) IncFailovers(network string) {
+	m.failovers.WithLabelValues(network, m.beacon).Inc()
+}
+
+func (m *Metrics) ObserveBlockFetchDuration(network string, fetchType BlockFetchType, duration time.Duration) {
+	m.blockFetchDuration.WithLabelValues(network, m.beacon, string(fetchType)).Observe(duration.Seconds())
+}
+
+func (m *Metrics) SetBeaconStatus(status BeaconStatus) {
+	for _, s := range beaconStatuses {
+		value := 0.0
+		if s == status {
+			value = 1
+		}
+
+		m.beaconStatus.WithLabelValues(string(s), m.beacon).Set(value)
+	}
+}
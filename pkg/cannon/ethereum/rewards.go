@@ -0,0 +1,119 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrBlockRewardsUnsupported is returned when the beacon node does not expose the block rewards endpoint.
+var ErrBlockRewardsUnsupported = errors.New("beacon node does not support the block rewards endpoint (/eth/v1/beacon/rewards/blocks)")
+
+// ErrBlockRewardsNotFound is returned when the beacon node has no block for the requested identifier.
+var ErrBlockRewardsNotFound = errors.New("block rewards not found")
+
+// BlockRewards is the proposer reward breakdown for a single block, in Gwei.
+type BlockRewards struct {
+	ProposerIndex     uint64
+	Total             uint64
+	Attestations      uint64
+	SyncAggregate     uint64
+	ProposerSlashings uint64
+	AttesterSlashings uint64
+}
+
+type blockRewardsResponse struct {
+	Data struct {
+		ProposerIndex     string `json:"proposer_index"`
+		Total             string `json:"total"`
+		Attestations      string `json:"attestations"`
+		SyncAggregate     string `json:"sync_aggregate"`
+		ProposerSlashings string `json:"proposer_slashings"`
+		AttesterSlashings string `json:"attester_slashings"`
+	} `json:"data"`
+}
+
+// GetBlockRewards fetches the proposer reward breakdown for the block from the beacon node's
+// /eth/v1/beacon/rewards/blocks/{block_id} endpoint.
+func (b *BeaconNode) GetBlockRewards(ctx context.Context, identifier string) (*BlockRewards, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/rewards/blocks/%s", strings.TrimSuffix(b.activeAddress(), "/"), identifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range b.config.BeaconNodeHeaders {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	start := time.Now()
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		b.observeBlockFetch(BlockFetchTypeRewards, start)
+
+		return nil, errors.Wrap(err, "failed to request block rewards")
+	}
+
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+
+	b.observeBlockFetch(BlockFetchTypeRewards, start)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read block rewards response")
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrBlockRewardsNotFound
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, ErrBlockRewardsUnsupported
+	default:
+		return nil, fmt.Errorf("unexpected status code %d fetching block rewards: %s", rsp.StatusCode, string(body))
+	}
+
+	var parsed blockRewardsResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, errors.Wrap(err, "failed to decode block rewards response")
+	}
+
+	rewards := &BlockRewards{}
+
+	for _, field := range []struct {
+		name  string
+		value string
+		dst   *uint64
+	}{
+		{"proposer_index", parsed.Data.ProposerIndex, &rewards.ProposerIndex},
+		{"total", parsed.Data.Total, &rewards.Total},
+		{"attestations", parsed.Data.Attestations, &rewards.Attestations},
+		{"sync_aggregate", parsed.Data.SyncAggregate, &rewards.SyncAggregate},
+		{"proposer_slashings", parsed.Data.ProposerSlashings, &rewards.ProposerSlashings},
+		{"attester_slashings", parsed.Data.AttesterSlashings, &rewards.AttesterSlashings},
+	} {
+		if field.value == "" {
+			return nil, fmt.Errorf("block rewards response is missing %s", field.name)
+		}
+
+		v, err := strconv.ParseUint(field.value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse block rewards %s", field.name)
+		}
+
+		*field.dst = v
+	}
+
+	return rewards, nil
+}
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -49,3 +52,51 @@ func ClientFromString(client string) Client {
 
 	return ClientUnknown
 }
+
+// Version is the semantic version a beacon node reports as part of its node version string.
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+}
+
+var versionPattern = regexp.MustCompile(`v?(\d+)\.(\d+)\.(\d+)`)
+
+// ParseVersion parses the first "major.minor.patch" version found in s, which may be a bare version
+// like "v4.6.0" or a full node version string like "Lighthouse/v4.6.0-1be5253/x86_64-linux".
+func ParseVersion(s string) (Version, error) {
+	matches := versionPattern.FindStringSubmatch(s)
+	if matches == nil {
+		return Version{}, fmt.Errorf("no version found in %q", s)
+	}
+
+	parts := make([]uint64, 3)
+
+	for i := range parts {
+		n, err := strconv.ParseUint(matches[i+1], 10, 64)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+
+		parts[i] = n
+	}
+
+	return Version{Major: parts[0], Minor: parts[1], Patch: parts[2]}, nil
+}
+
+// LessThan reports whether v is an older version than other.
+func (v Version) LessThan(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+
+	return v.Patch < other.Patch
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
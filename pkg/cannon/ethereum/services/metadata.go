@@ -18,10 +18,12 @@ import (
 )
 
 type MetadataService struct {
-	beacon beacon.Node
+	// beacon returns the beacon node currently serving requests.
+	beacon func() beacon.Node
 	log    logrus.FieldLogger
 
 	overrideNetworkName string
+	overrideNetworkID   *uint64
 	Network             *networks.Network
 
 	Genesis *v1.Genesis
@@ -34,7 +36,7 @@ type MetadataService struct {
 	mu sync.Mutex
 }
 
-func NewMetadataService(log logrus.FieldLogger, sbeacon beacon.Node) MetadataService {
+func NewMetadataService(log logrus.FieldLogger, sbeacon func() beacon.Node) MetadataService {
 	return MetadataService{
 		overrideNetworkName: "",
 		beacon:              sbeacon,
@@ -51,6 +53,12 @@ func (m *MetadataService) OverrideNetworkName(name string) {
 	m.overrideNetworkName = name
 }
 
+func (m *MetadataService) OverrideNetworkID(id uint64) {
+	m.log.WithField("id", id).Info("Overriding network ID")
+
+	m.overrideNetworkID = &id
+}
+
 func (m *MetadataService) Start(ctx context.Context) error {
 	go func() {
 		operation := func() error {
@@ -171,6 +179,10 @@ func (m *MetadataService) DeriveNetwork(_ context.Context) error {
 
 	network.ID = m.Spec.DepositChainID
 
+	if m.overrideNetworkID != nil {
+		network.ID = *m.overrideNetworkID
+	}
+
 	if network.Name != m.Network.Name {
 		m.log.WithFields(logrus.Fields{
 			"name": network.Name,
@@ -184,7 +196,7 @@ func (m *MetadataService) DeriveNetwork(_ context.Context) error {
 }
 
 func (m *MetadataService) fetchSpec(_ context.Context) error {
-	spec, err := m.beacon.Spec()
+	spec, err := m.beacon().Spec()
 	if err != nil {
 		return err
 	}
@@ -195,7 +207,7 @@ func (m *MetadataService) fetchSpec(_ context.Context) error {
 }
 
 func (m *MetadataService) fetchGenesis(_ context.Context) error {
-	genesis, err := m.beacon.Genesis()
+	genesis, err := m.beacon().Genesis()
 	if err != nil {
 		return err
 	}
@@ -206,7 +218,7 @@ func (m *MetadataService) fetchGenesis(_ context.Context) error {
 }
 
 func (m *MetadataService) NodeVersion(_ context.Context) string {
-	version, _ := m.beacon.NodeVersion()
+	version, _ := m.beacon().NodeVersion()
 
 	return version
 }
@@ -0,0 +1,297 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidSlashing is returned when a slashing included in a block does not prove a slashable offence.
+var ErrInvalidSlashing = errors.New("invalid slashing")
+
+var (
+	domainBeaconProposer = phase0.DomainType{0x00, 0x00, 0x00, 0x00}
+	domainBeaconAttester = phase0.DomainType{0x01, 0x00, 0x00, 0x00}
+)
+
+func invalidSlashing(reason string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidSlashing, reason)
+}
+
+// VerifyProposerSlashing checks that both headers are for the same slot and proposer, that they conflict and that
+// both are signed by the proposer. ErrInvalidSlashing is returned if the slashing is malformed.
+func (b *BeaconNode) VerifyProposerSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	err := b.verifyProposerSlashing(ctx, slashing)
+	if errors.Is(err, ErrInvalidSlashing) {
+		b.metrics.IncInvalidSlashings(string(b.Metadata().Network.Name), "proposer")
+	}
+
+	return err
+}
+
+func (b *BeaconNode) verifyProposerSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	if slashing == nil || slashing.SignedHeader1 == nil || slashing.SignedHeader2 == nil ||
+		slashing.SignedHeader1.Message == nil || slashing.SignedHeader2.Message == nil {
+		return invalidSlashing("missing header")
+	}
+
+	header1, header2 := slashing.SignedHeader1.Message, slashing.SignedHeader2.Message
+
+	if header1.Slot != header2.Slot {
+		return invalidSlashing("headers are for different slots")
+	}
+
+	if header1.ProposerIndex != header2.ProposerIndex {
+		return invalidSlashing("headers have different proposers")
+	}
+
+	root1, err := header1.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to hash header")
+	}
+
+	root2, err := header2.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to hash header")
+	}
+
+	if root1 == root2 {
+		return invalidSlashing("headers are identical")
+	}
+
+	pubkeys, err := b.fetchValidatorPubkeys(ctx, []phase0.ValidatorIndex{header1.ProposerIndex})
+	if err != nil {
+		return err
+	}
+
+	epoch := phase0.Epoch(uint64(header1.Slot) / uint64(b.Metadata().Spec.SlotsPerEpoch))
+
+	for _, signed := range []*phase0.SignedBeaconBlockHeader{slashing.SignedHeader1, slashing.SignedHeader2} {
+		root, err := signed.Message.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to hash header")
+		}
+
+		if err := b.verifySignature(domainBeaconProposer, epoch, root, signed.Signature, pubkeys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyAttesterSlashing checks that the attestations are a double or surround vote, that they share at least one
+// attester and that both aggregate signatures are valid. ErrInvalidSlashing is returned if the slashing is malformed.
+func (b *BeaconNode) VerifyAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	err := b.verifyAttesterSlashing(ctx, slashing)
+	if errors.Is(err, ErrInvalidSlashing) {
+		b.metrics.IncInvalidSlashings(string(b.Metadata().Network.Name), "attester")
+	}
+
+	return err
+}
+
+func (b *BeaconNode) verifyAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	if slashing == nil || slashing.Attestation1 == nil || slashing.Attestation2 == nil ||
+		slashing.Attestation1.Data == nil || slashing.Attestation2.Data == nil {
+		return invalidSlashing("missing attestation")
+	}
+
+	data1, data2 := slashing.Attestation1.Data, slashing.Attestation2.Data
+
+	root1, err := data1.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to hash attestation data")
+	}
+
+	root2, err := data2.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to hash attestation data")
+	}
+
+	doubleVote := root1 != root2 && data1.Target.Epoch == data2.Target.Epoch
+	surroundVote := data1.Source.Epoch < data2.Source.Epoch && data2.Target.Epoch < data1.Target.Epoch
+
+	if !doubleVote && !surroundVote {
+		return invalidSlashing("attestations are neither a double nor a surround vote")
+	}
+
+	if !intersects(slashing.Attestation1.AttestingIndices, slashing.Attestation2.AttestingIndices) {
+		return invalidSlashing("attestations have no attesters in common")
+	}
+
+	for _, attestation := range []*phase0.IndexedAttestation{slashing.Attestation1, slashing.Attestation2} {
+		if len(attestation.AttestingIndices) == 0 {
+			return invalidSlashing("attestation has no attesters")
+		}
+
+		indices := make([]phase0.ValidatorIndex, 0, len(attestation.AttestingIndices))
+
+		for i, index := range attestation.AttestingIndices {
+			if i > 0 && index <= attestation.AttestingIndices[i-1] {
+				return invalidSlashing("attesting indices are not sorted and unique")
+			}
+
+			indices = append(indices, phase0.ValidatorIndex(index))
+		}
+
+		pubkeys, err := b.fetchValidatorPubkeys(ctx, indices)
+		if err != nil {
+			return err
+		}
+
+		root, err := attestation.Data.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to hash attestation data")
+		}
+
+		if err := b.verifySignature(domainBeaconAttester, attestation.Data.Target.Epoch, root, attestation.Signature, pubkeys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func intersects(a, b []uint64) bool {
+	seen := make(map[uint64]struct{}, len(a))
+	for _, index := range a {
+		seen[index] = struct{}{}
+	}
+
+	for _, index := range b {
+		if _, ok := seen[index]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// domain computes the signature domain for the fork active at the epoch.
+func (b *BeaconNode) domain(domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	genesis := b.Metadata().Genesis
+	if genesis == nil || b.Metadata().Spec == nil {
+		return phase0.Domain{}, errors.New("genesis and spec are required to compute the signing domain")
+	}
+
+	version := genesis.GenesisForkVersion
+	activation := phase0.Epoch(0)
+
+	for _, fork := range b.Metadata().Spec.ForkEpochs {
+		if fork.Epoch > epoch || fork.Epoch < activation {
+			continue
+		}
+
+		raw, err := hex.DecodeString(strings.TrimPrefix(fork.Version, "0x"))
+		if err != nil || len(raw) != len(version) {
+			return phase0.Domain{}, fmt.Errorf("invalid fork version %q for fork %s", fork.Version, fork.Name)
+		}
+
+		copy(version[:], raw)
+		activation = fork.Epoch
+	}
+
+	forkDataRoot, err := (&phase0.ForkData{
+		CurrentVersion:        version,
+		GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+	}).HashTreeRoot()
+	if err != nil {
+		return phase0.Domain{}, errors.Wrap(err, "failed to compute fork data root")
+	}
+
+	var domain phase0.Domain
+
+	copy(domain[:4], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return domain, nil
+}
+
+type validatorsResponse struct {
+	Data []struct {
+		Index     string `json:"index"`
+		Validator struct {
+			Pubkey string `json:"pubkey"`
+		} `json:"validator"`
+	} `json:"data"`
+}
+
+// fetchValidatorPubkeys fetches the pubkeys of the validators, in the same order as the indices.
+func (b *BeaconNode) fetchValidatorPubkeys(ctx context.Context, indices []phase0.ValidatorIndex) ([]phase0.BLSPubKey, error) {
+	ids := make([]string, len(indices))
+	for i, index := range indices {
+		ids[i] = strconv.FormatUint(uint64(index), 10)
+	}
+
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/head/validators?id=%s", strings.TrimSuffix(b.activeAddress(), "/"), strings.Join(ids, ","))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range b.config.BeaconNodeHeaders {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, NewNodeUnavailableError(errors.Wrap(err, "failed to request validators"))
+	}
+
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read validators response")
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d fetching validators: %s", rsp.StatusCode, string(bytes.TrimSpace(body)))
+	}
+
+	var parsed validatorsResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, errors.Wrap(err, "failed to decode validators response")
+	}
+
+	byIndex := make(map[string]phase0.BLSPubKey, len(parsed.Data))
+
+	for _, validator := range parsed.Data {
+		raw, err := hex.DecodeString(strings.TrimPrefix(validator.Validator.Pubkey, "0x"))
+		if err != nil || len(raw) != len(phase0.BLSPubKey{}) {
+			return nil, fmt.Errorf("invalid pubkey for validator %s", validator.Index)
+		}
+
+		var pubkey phase0.BLSPubKey
+
+		copy(pubkey[:], raw)
+
+		byIndex[validator.Index] = pubkey
+	}
+
+	pubkeys := make([]phase0.BLSPubKey, len(ids))
+
+	for i, id := range ids {
+		pubkey, ok := byIndex[id]
+		if !ok {
+			return nil, invalidSlashing(fmt.Sprintf("unknown validator %s", id))
+		}
+
+		pubkeys[i] = pubkey
+	}
+
+	return pubkeys, nil
+}
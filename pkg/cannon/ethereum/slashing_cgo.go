@@ -0,0 +1,68 @@
+//go:build cgo
+
+package ethereum
+
+import (
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+)
+
+// BLSAvailable reports whether slashing signatures can be verified. The bls library requires cgo.
+const BLSAvailable = true
+
+var (
+	blsInitOnce sync.Once
+	errBLSInit  error
+)
+
+func initBLS() error {
+	blsInitOnce.Do(func() {
+		if err := bls.Init(bls.BLS12_381); err != nil {
+			errBLSInit = err
+
+			return
+		}
+
+		errBLSInit = bls.SetETHmode(bls.EthModeDraft07)
+	})
+
+	return errBLSInit
+}
+
+// verifySignature verifies the (aggregate) signature of the pubkeys over the object root in the domain at the epoch.
+func (b *BeaconNode) verifySignature(domainType phase0.DomainType, epoch phase0.Epoch, objectRoot phase0.Root, signature phase0.BLSSignature, pubkeys []phase0.BLSPubKey) error {
+	if err := initBLS(); err != nil {
+		return errors.Wrap(err, "failed to initialise bls")
+	}
+
+	domain, err := b.domain(domainType, epoch)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := (&phase0.SigningData{ObjectRoot: objectRoot, Domain: domain}).HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to compute signing root")
+	}
+
+	var sig bls.Sign
+	if err := sig.Deserialize(signature[:]); err != nil {
+		return invalidSlashing("malformed signature")
+	}
+
+	keys := make([]bls.PublicKey, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		if err := keys[i].Deserialize(pubkey[:]); err != nil {
+			return errors.Wrapf(err, "failed to deserialize validator pubkey %#x", pubkey)
+		}
+	}
+
+	if !sig.FastAggregateVerify(keys, signingRoot[:]) {
+		return invalidSlashing("signature does not verify")
+	}
+
+	return nil
+}
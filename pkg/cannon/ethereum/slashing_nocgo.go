@@ -0,0 +1,15 @@
+//go:build !cgo
+
+package ethereum
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BLSAvailable reports whether slashing signatures can be verified. The bls library requires cgo.
+const BLSAvailable = false
+
+func (b *BeaconNode) verifySignature(domainType phase0.DomainType, epoch phase0.Epoch, objectRoot phase0.Root, signature phase0.BLSSignature, pubkeys []phase0.BLSPubKey) error {
+	return errors.New("bls signature verification requires a cgo build")
+}
@@ -0,0 +1,105 @@
+package ethereum
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethpandaops/beacon/pkg/beacon"
+)
+
+type BeaconStatus string
+
+const (
+	BeaconStatusDisconnected BeaconStatus = "disconnected"
+	BeaconStatusSyncing      BeaconStatus = "syncing"
+	BeaconStatusReady        BeaconStatus = "ready"
+)
+
+var beaconStatuses = []BeaconStatus{
+	BeaconStatusDisconnected,
+	BeaconStatusSyncing,
+	BeaconStatusReady,
+}
+
+// statusTracker derives the beacon node connection status from the active node's health check and sync events.
+type statusTracker struct {
+	mu      sync.Mutex
+	healthy bool
+	syncing bool
+	status  BeaconStatus
+	metrics *Metrics
+}
+
+func newStatusTracker(metrics *Metrics) *statusTracker {
+	t := &statusTracker{
+		syncing: true,
+		status:  BeaconStatusDisconnected,
+		metrics: metrics,
+	}
+
+	metrics.SetBeaconStatus(t.status)
+
+	return t
+}
+
+func (t *statusTracker) subscribe(ctx context.Context, node beacon.Node, active func() bool) {
+	node.OnHealthCheckFailed(ctx, func(ctx context.Context, event *beacon.HealthCheckFailedEvent) error {
+		if !active() {
+			return nil
+		}
+
+		t.update(func() { t.healthy = false })
+
+		return nil
+	})
+
+	node.OnHealthCheckSucceeded(ctx, func(ctx context.Context, event *beacon.HealthCheckSucceededEvent) error {
+		if !active() {
+			return nil
+		}
+
+		t.update(func() { t.healthy = true })
+
+		return nil
+	})
+
+	node.OnSyncStatus(ctx, func(ctx context.Context, event *beacon.SyncStatusEvent) error {
+		if event == nil || event.State == nil || !active() {
+			return nil
+		}
+
+		t.update(func() { t.syncing = event.State.IsSyncing || event.State.SyncDistance > 3 })
+
+		return nil
+	})
+}
+
+func (t *statusTracker) update(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fn()
+
+	status := BeaconStatusReady
+
+	switch {
+	case !t.healthy:
+		status = BeaconStatusDisconnected
+	case t.syncing:
+		status = BeaconStatusSyncing
+	}
+
+	if status == t.status {
+		return
+	}
+
+	t.status = status
+	t.metrics.SetBeaconStatus(status)
+}
+
+func (t *statusTracker) Status() BeaconStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.status
+}
@@ -0,0 +1,96 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrStateUnavailable is returned when the beacon node can't serve the requested state, e.g. a historical state
+// on a node that isn't an archive node.
+var ErrStateUnavailable = errors.New("beacon node can not serve the requested state")
+
+// ValidatorEpochs are the lifecycle epochs of a validator in a given state.
+type ValidatorEpochs struct {
+	ExitEpoch         uint64
+	WithdrawableEpoch uint64
+}
+
+type validatorResponse struct {
+	Data struct {
+		Validator struct {
+			ExitEpoch         string `json:"exit_epoch"`
+			WithdrawableEpoch string `json:"withdrawable_epoch"`
+		} `json:"validator"`
+	} `json:"data"`
+}
+
+// GetValidatorEpochs fetches the exit and withdrawable epochs of the validator in the state from the beacon
+// node's /eth/v1/beacon/states/{state_id}/validators/{validator_id} endpoint.
+func (b *BeaconNode) GetValidatorEpochs(ctx context.Context, stateID string, index uint64) (*ValidatorEpochs, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/%s/validators/%d", strings.TrimSuffix(b.activeAddress(), "/"), stateID, index)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range b.config.BeaconNodeHeaders {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	start := time.Now()
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		b.observeBlockFetch(BlockFetchTypeState, start)
+
+		return nil, errors.Wrap(err, "failed to request validator")
+	}
+
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+
+	b.observeBlockFetch(BlockFetchTypeState, start)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read validator response")
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, errors.Wrapf(ErrStateUnavailable, "status code %d", rsp.StatusCode)
+	default:
+		return nil, fmt.Errorf("unexpected status code %d fetching validator %d at state %s: %s", rsp.StatusCode, index, stateID, string(body))
+	}
+
+	var parsed validatorResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, errors.Wrap(err, "failed to decode validator response")
+	}
+
+	exitEpoch, err := strconv.ParseUint(parsed.Data.Validator.ExitEpoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator exit_epoch")
+	}
+
+	withdrawableEpoch, err := strconv.ParseUint(parsed.Data.Validator.WithdrawableEpoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator withdrawable_epoch")
+	}
+
+	return &ValidatorEpochs{
+		ExitEpoch:         exitEpoch,
+		WithdrawableEpoch: withdrawableEpoch,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package cannon
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type eventCountsResponse struct {
+	Since  time.Time         `json:"since"`
+	Counts map[string]uint64 `json:"counts"`
+}
+
+// handleEventCounts reports how many events each deriver has emitted since startup, as a quick check that
+// derivers are producing data without needing Prometheus.
+func (c *Cannon) handleEventCounts(w http.ResponseWriter, r *http.Request) {
+	counts, err := c.metrics.DeriverEventCounts()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	response := eventCountsResponse{
+		Since:  c.metrics.StartedAt().UTC(),
+		Counts: counts,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		c.log.WithError(err).Debug("Failed to write event counts response")
+	}
+}
@@ -0,0 +1,30 @@
+package eventbatch
+
+import (
+	"context"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+)
+
+// Chunked wraps an events callback so it is called with at most maxEventsPerBatch events at a time, in order.
+// The first error stops the remaining batches from being sent. A maxEventsPerBatch of 0 or less returns fn as is.
+func Chunked(maxEventsPerBatch int, fn func(ctx context.Context, events []*xatu.DecoratedEvent) error) func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	if maxEventsPerBatch <= 0 {
+		return fn
+	}
+
+	return func(ctx context.Context, events []*xatu.DecoratedEvent) error {
+		for start := 0; start < len(events); start += maxEventsPerBatch {
+			end := start + maxEventsPerBatch
+			if end > len(events) {
+				end = len(events)
+			}
+
+			if err := fn(ctx, events[start:end]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
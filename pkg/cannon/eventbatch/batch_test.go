@@ -0,0 +1,101 @@
+package eventbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEvents(n int) []*xatu.DecoratedEvent {
+	events := make([]*xatu.DecoratedEvent, n)
+
+	for i := range events {
+		events[i] = &xatu.DecoratedEvent{Event: &xatu.Event{Id: fmt.Sprintf("%d", i)}}
+	}
+
+	return events
+}
+
+func TestChunkedBatchSizes(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxEventsPerBatch int
+		events            int
+		expectedBatches   int
+	}{
+		{name: "smaller than batch", maxEventsPerBatch: 10, events: 3, expectedBatches: 1},
+		{name: "exact multiple", maxEventsPerBatch: 10, events: 30, expectedBatches: 3},
+		{name: "remainder", maxEventsPerBatch: 10, events: 345, expectedBatches: 35},
+		{name: "batch of one", maxEventsPerBatch: 1, events: 5, expectedBatches: 5},
+		{name: "no events", maxEventsPerBatch: 10, events: 0, expectedBatches: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := newEvents(tt.events)
+
+			received := []*xatu.DecoratedEvent{}
+
+			batches := 0
+
+			fn := Chunked(tt.maxEventsPerBatch, func(ctx context.Context, batch []*xatu.DecoratedEvent) error {
+				assert.LessOrEqual(t, len(batch), tt.maxEventsPerBatch)
+				assert.NotEmpty(t, batch)
+
+				batches++
+
+				received = append(received, batch...)
+
+				return nil
+			})
+
+			require.NoError(t, fn(context.Background(), events))
+
+			assert.Equal(t, tt.expectedBatches, batches)
+			assert.Equal(t, events, received)
+		})
+	}
+}
+
+func TestChunkedDisabled(t *testing.T) {
+	events := newEvents(100)
+
+	batches := 0
+
+	fn := Chunked(0, func(ctx context.Context, batch []*xatu.DecoratedEvent) error {
+		batches++
+
+		assert.Len(t, batch, len(events))
+
+		return nil
+	})
+
+	require.NoError(t, fn(context.Background(), events))
+	assert.Equal(t, 1, batches)
+}
+
+func TestChunkedStopsOnError(t *testing.T) {
+	expected := errors.New("sink failed")
+
+	batches := 0
+
+	fn := Chunked(10, func(ctx context.Context, batch []*xatu.DecoratedEvent) error {
+		batches++
+
+		if batches == 2 {
+			return expected
+		}
+
+		return nil
+	})
+
+	err := fn(context.Background(), newEvents(50))
+
+	assert.ErrorIs(t, err, expected)
+	assert.Equal(t, 2, batches)
+}
@@ -0,0 +1,17 @@
+package eventbatch
+
+import "errors"
+
+type Config struct {
+	// MaxEventsPerBatch caps how many events are handed to the sinks at once. Larger result sets, e.g. every
+	// transaction in a block, are split into multiple batches. 0 disables the cap.
+	MaxEventsPerBatch int `yaml:"maxEventsPerBatch" default:"0"`
+}
+
+func (c *Config) Validate() error {
+	if c.MaxEventsPerBatch < 0 {
+		return errors.New("maxEventsPerBatch must be greater than or equal to 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package cannon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/output"
+)
+
+// sinkHealthCheckTimeout bounds each sink health check so a hanging upstream can't stall the readiness probe.
+const sinkHealthCheckTimeout = 5 * time.Second
+
+type readinessResponse struct {
+	Ready  bool                 `json:"ready"`
+	Beacon beaconHealthResponse `json:"beacon"`
+	Sinks  []sinkHealthResponse `json:"sinks"`
+}
+
+type sinkHealthResponse struct {
+	Name     string `json:"name"`
+	Optional bool   `json:"optional"`
+	Healthy  bool   `json:"healthy"`
+	Error    string `json:"error,omitempty"`
+}
+
+type beaconHealthResponse struct {
+	Synced bool   `json:"synced"`
+	Error  string `json:"error,omitempty"`
+}
+
+// handleHealthz reports that the process is up.
+func (c *Cannon) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write([]byte("ok")); err != nil {
+		c.log.WithError(err).Debug("Failed to write healthz response")
+	}
+}
+
+// handleReadyz reports whether the beacon node is ready, the event derivers have been started and the sinks that
+// aren't optional can accept events.
+func (c *Cannon) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	response := readinessResponse{
+		Ready: c.ready.Load(),
+		Sinks: c.checkSinkHealth(r.Context()),
+	}
+
+	for _, sink := range response.Sinks {
+		if !sink.Healthy && !sink.Optional {
+			response.Ready = false
+		}
+	}
+
+	if err := c.beacon.Synced(r.Context()); err != nil {
+		response.Beacon.Error = err.Error()
+	} else {
+		response.Beacon.Synced = true
+	}
+
+	status := http.StatusOK
+	if !response.Ready {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		c.log.WithError(err).Debug("Failed to write readyz response")
+	}
+}
+
+// checkSinkHealth checks every sink concurrently. Sinks are not checked in dry run mode as nothing is sent to them.
+func (c *Cannon) checkSinkHealth(ctx context.Context) []sinkHealthResponse {
+	if c.Config.DryRun {
+		return []sinkHealthResponse{}
+	}
+
+	optional := make(map[string]bool, len(c.Config.Outputs))
+	for _, out := range c.Config.Outputs {
+		optional[out.Name] = out.Optional
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sinkHealthCheckTimeout)
+	defer cancel()
+
+	results := make([]sinkHealthResponse, len(c.sinks))
+
+	var wg sync.WaitGroup
+
+	for i, sink := range c.sinks {
+		wg.Add(1)
+
+		go func(i int, sink output.Sink) {
+			defer wg.Done()
+
+			result := sinkHealthResponse{
+				Name:     sink.Name(),
+				Optional: optional[sink.Name()],
+				Healthy:  true,
+			}
+
+			if err := output.CheckHealth(ctx, sink); err != nil {
+				result.Healthy = false
+				result.Error = err.Error()
+			}
+
+			results[i] = result
+		}(i, sink)
+	}
+
+	wg.Wait()
+
+	return results
+}
@@ -7,7 +7,7 @@ type BlockprintMetrics struct {
 	Currentslot *prometheus.GaugeVec
 }
 
-func NewBlockprintMetrics(namespace string) BlockprintMetrics {
+func NewBlockprintMetrics(namespace string, registerer prometheus.Registerer) BlockprintMetrics {
 	namespace += "_slot_iterator"
 
 	s := BlockprintMetrics{
@@ -23,8 +23,8 @@ func NewBlockprintMetrics(namespace string) BlockprintMetrics {
 		}, []string{"cannon_type", "network"}),
 	}
 
-	prometheus.MustRegister(s.Targetslot)
-	prometheus.MustRegister(s.Currentslot)
+	registerer.MustRegister(s.Targetslot)
+	registerer.MustRegister(s.Currentslot)
 
 	return s
 }
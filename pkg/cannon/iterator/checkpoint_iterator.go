@@ -16,6 +16,15 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// unavailablePause is how long the iterator waits before trying again once the coordinator client has given up
+// or the beacon node is unreachable.
+const unavailablePause = time.Minute
+
+// ErrCheckpointIteratorFinished is returned by Next once a ranged iterator has moved past its end slot.
+var ErrCheckpointIteratorFinished = errors.New("checkpoint iterator has reached its end slot")
+
+// CheckpointIterator hands out one location per epoch, up to the latest checkpoint, and stores the epoch as
+// the coordinator location.
 type CheckpointIterator struct {
 	log            logrus.FieldLogger
 	cannonType     xatu.CannonType
@@ -26,9 +35,23 @@ type CheckpointIterator struct {
 	metrics        *CheckpointMetrics
 	beaconNode     *ethereum.BeaconNode
 	checkpointName string
+
+	// startEpoch overrides the network default start location when the coordinator has no location stored yet.
+	startEpoch *phase0.Epoch
+
+	// slotRange is set when the iterator has been restricted to a specific range. In this mode the location is
+	// tracked in memory and the coordinator is never consulted or updated.
+	slotRange     *SlotRange
+	rangeLocation *xatu.CannonLocation
+
+	config *CheckpointConfig
+
+	// round is the wallclock slot the iterator is currently charging the slots of handed out epochs to.
+	round      uint64
+	roundSlots uint64
 }
 
-func NewCheckpointIterator(log logrus.FieldLogger, networkName, networkID string, cannonType xatu.CannonType, coordinatorClient *coordinator.Client, wallclock *ethwallclock.EthereumBeaconChain, metrics *CheckpointMetrics, beacon *ethereum.BeaconNode, checkpoint string) *CheckpointIterator {
+func NewCheckpointIterator(log logrus.FieldLogger, networkName, networkID string, cannonType xatu.CannonType, coordinatorClient *coordinator.Client, wallclock *ethwallclock.EthereumBeaconChain, metrics *CheckpointMetrics, beacon *ethereum.BeaconNode, checkpoint string, config *CheckpointConfig) *CheckpointIterator {
 	return &CheckpointIterator{
 		log: log.
 			WithField("module", "cannon/iterator/checkpoint_iterator").
@@ -41,13 +64,97 @@ func NewCheckpointIterator(log logrus.FieldLogger, networkName, networkID string
 		beaconNode:     beacon,
 		metrics:        metrics,
 		checkpointName: checkpoint,
+		config:         config,
 	}
 }
 
+// SetSlotRange restricts the iterator to the given range. Next returns ErrCheckpointIteratorFinished once the
+// iterator has moved past the end of the range. A range without a start slot is ignored.
+func (c *CheckpointIterator) SetSlotRange(slotRange SlotRange) {
+	if !slotRange.Enabled() {
+		return
+	}
+
+	c.slotRange = &slotRange
+	c.rangeLocation = nil
+
+	c.log = c.log.WithField("start_slot", *slotRange.StartSlot)
+
+	if slotRange.EndSlot != nil {
+		c.log = c.log.WithField("end_slot", *slotRange.EndSlot)
+	}
+}
+
+// SetStartEpoch sets the epoch the iterator should start from if the coordinator does not have a location stored yet.
+func (c *CheckpointIterator) SetStartEpoch(epoch phase0.Epoch) {
+	c.startEpoch = &epoch
+}
+
 func (c *CheckpointIterator) UpdateLocation(ctx context.Context, location *xatu.CannonLocation) error {
+	if c.slotRange != nil {
+		c.rangeLocation = location
+
+		return nil
+	}
+
 	return c.coordinator.UpsertCannonLocationRequest(ctx, location)
 }
 
+func (c *CheckpointIterator) getCurrentLocation(ctx context.Context) (*xatu.CannonLocation, error) {
+	if c.slotRange != nil {
+		return c.rangeLocation, nil
+	}
+
+	return c.coordinator.GetCannonLocation(ctx, c.cannonType, c.networkID)
+}
+
+func (c *CheckpointIterator) getStartEpoch() phase0.Epoch {
+	if c.slotRange != nil {
+		return c.slotRange.StartEpoch(c.slotsPerEpoch())
+	}
+
+	if c.startEpoch != nil {
+		c.log.WithField("start_epoch", *c.startEpoch).Info("No location stored by the coordinator, seeding from configured start epoch")
+
+		return *c.startEpoch
+	}
+
+	return phase0.Epoch(GetDefaultSlotLocation(c.beaconNode.Metadata().Spec.ForkEpochs, c.cannonType) / 32)
+}
+
+func (c *CheckpointIterator) slotsPerEpoch() uint64 {
+	return uint64(c.beaconNode.Metadata().Spec.SlotsPerEpoch)
+}
+
+func (c *CheckpointIterator) isPastEndSlot(epoch phase0.Epoch) bool {
+	if c.slotRange == nil {
+		return false
+	}
+
+	return c.slotRange.IsPastEnd(epoch, c.slotsPerEpoch())
+}
+
+// FilterSlotRange drops events for slots outside the range set with SetSlotRange. The first and last epochs of a
+// range are only partially inside it, but derivers process whole epochs. Events without a slot are kept.
+func (c *CheckpointIterator) FilterSlotRange(events []*xatu.DecoratedEvent) []*xatu.DecoratedEvent {
+	if c.slotRange == nil {
+		return events
+	}
+
+	filtered := make([]*xatu.DecoratedEvent, 0, len(events))
+
+	for _, event := range events {
+		slot, ok := xatu.EventSlot(event)
+		if ok && !c.slotRange.Contains(slot) {
+			continue
+		}
+
+		filtered = append(filtered, event)
+	}
+
+	return filtered
+}
+
 func (c *CheckpointIterator) Next(ctx context.Context) (next *xatu.CannonLocation, lookAhead []*xatu.CannonLocation, err error) {
 	ctx, span := observability.Tracer().Start(ctx,
 		"CheckpointIterator.Next",
@@ -61,7 +168,7 @@ func (c *CheckpointIterator) Next(ctx context.Context) (next *xatu.CannonLocatio
 		if err != nil {
 			span.RecordError(err)
 		} else {
-			epoch, err := c.getEpochFromLocation(next)
+			epoch, err := EpochFromLocation(next)
 			if err == nil {
 				span.SetAttributes(attribute.Int64("next", int64(epoch)))
 			}
@@ -74,6 +181,10 @@ func (c *CheckpointIterator) Next(ctx context.Context) (next *xatu.CannonLocatio
 		// Grab the current checkpoint from the beacon node
 		checkpoint, err := c.fetchLatestEpoch(ctx)
 		if err != nil {
+			if errors.Is(err, ethereum.ErrNodeUnavailable) {
+				c.pause(ctx, err, "Beacon node is unavailable")
+			}
+
 			return nil, []*xatu.CannonLocation{}, errors.Wrap(err, "failed to fetch latest checkpoint")
 		}
 
@@ -82,31 +193,47 @@ func (c *CheckpointIterator) Next(ctx context.Context) (next *xatu.CannonLocatio
 		}
 
 		// Check where we are at from the coordinator
-		location, err := c.coordinator.GetCannonLocation(ctx, c.cannonType, c.networkID)
+		location, err := c.getCurrentLocation(ctx)
 		if err != nil {
+			if errors.Is(err, coordinator.ErrCoordinatorUnavailable) {
+				c.pause(ctx, err, "Coordinator is unavailable")
+			}
+
 			return nil, []*xatu.CannonLocation{}, errors.Wrap(err, "failed to get cannon location")
 		}
 
-		// If location is empty we haven't started yet, start at the network default for the type. If the network default
-		// is empty, we'll start at epoch 0.
+		// If location is empty we haven't started yet, start at the configured start slot/epoch or the network default
+		// for the type. If the network default is empty, we'll start at epoch 0.
 		if location == nil {
-			location, err = c.createLocationFromEpochNumber(phase0.Epoch(GetDefaultSlotLocation(c.beaconNode.Metadata().Spec.ForkEpochs, c.cannonType) / 32))
+			startEpoch := c.getStartEpoch()
+
+			if c.isPastEndSlot(startEpoch) {
+				return nil, []*xatu.CannonLocation{}, ErrCheckpointIteratorFinished
+			}
+
+			location, err = c.createLocationFromEpochNumber(startEpoch)
 			if err != nil {
 				return nil, []*xatu.CannonLocation{}, errors.Wrap(err, "failed to create location from slot number 0")
 			}
 
+			if err := c.waitForRoundBudget(ctx); err != nil {
+				return nil, []*xatu.CannonLocation{}, err
+			}
+
 			return location, c.getLookAheads(ctx, location), nil
 		}
 
 		// If the location is the same as the current checkpoint, we should sleep until the next epoch
-		locationEpoch, err := c.getEpochFromLocation(location)
+		locationEpoch, err := EpochFromLocation(location)
 		if err != nil {
 			return nil, []*xatu.CannonLocation{}, errors.Wrap(err, "failed to get epoch from location")
 		}
 
-		c.metrics.SetTrailingEpochs(c.cannonType.String(), c.networkName, c.checkpointName, float64(checkpoint.Epoch-locationEpoch))
+		target := c.targetEpoch(checkpoint)
 
-		if locationEpoch >= checkpoint.Epoch {
+		c.metrics.SetTrailingEpochs(c.cannonType.String(), c.networkName, c.checkpointName, float64(target-locationEpoch))
+
+		if locationEpoch >= target {
 			// Sleep until the next epoch
 			epoch := c.wallclock.Epochs().Current()
 
@@ -116,32 +243,91 @@ func (c *CheckpointIterator) Next(ctx context.Context) (next *xatu.CannonLocatio
 				"current_epoch":    epoch.Number(),
 				"sleep_for":        sleepFor.String(),
 				"checkpoint_epoch": checkpoint.Epoch,
+				"target_epoch":     target,
 			}).Trace("Sleeping until next epoch")
 
-			time.Sleep(sleepFor)
-
 			// Sleep for an additional 5 seconds to give the beacon node time to do epoch processing.
-			time.Sleep(5 * time.Second)
+			timer := time.NewTimer(sleepFor + 5*time.Second)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return nil, []*xatu.CannonLocation{}, ctx.Err()
+			case <-timer.C:
+			}
 
 			continue
 		}
 
 		nextEpoch := locationEpoch + 1
 
+		if c.isPastEndSlot(nextEpoch) {
+			return nil, []*xatu.CannonLocation{}, ErrCheckpointIteratorFinished
+		}
+
 		current, err := c.createLocationFromEpochNumber(nextEpoch)
 		if err != nil {
 			return nil, []*xatu.CannonLocation{}, errors.Wrap(err, "failed to create location from epoch number")
 		}
 
+		if err := c.waitForRoundBudget(ctx); err != nil {
+			return nil, []*xatu.CannonLocation{}, err
+		}
+
 		c.metrics.SetCurrentEpoch(c.cannonType.String(), c.networkName, c.checkpointName, float64(nextEpoch))
 
 		return current, c.getLookAheads(ctx, current), nil
 	}
 }
 
+// waitForRoundBudget charges an epoch's slots to the current round, yielding until the next round if they don't fit
+// in what is left of the round's budget. Locations are whole epochs, so a round always allows at least one epoch
+// even when the budget is smaller than an epoch.
+func (c *CheckpointIterator) waitForRoundBudget(ctx context.Context) error {
+	if c.config == nil || c.config.MaxSlotsPerRound == 0 {
+		return nil
+	}
+
+	slots := c.slotsPerEpoch()
+
+	for {
+		slot := c.wallclock.Slots().Current()
+
+		if slot.Number() != c.round {
+			c.round = slot.Number()
+			c.roundSlots = 0
+		}
+
+		if c.roundSlots == 0 || c.roundSlots+slots <= c.config.MaxSlotsPerRound {
+			c.roundSlots += slots
+
+			return nil
+		}
+
+		c.log.WithField("max_slots_per_round", c.config.MaxSlotsPerRound).Trace("Round budget used up, yielding until the next round")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(slot.TimeWindow().End())):
+		}
+	}
+}
+
+// pause blocks for a while after a dependency has become unavailable so we don't spin against it while it recovers.
+func (c *CheckpointIterator) pause(ctx context.Context, err error, reason string) {
+	c.log.WithError(err).WithField("pause", unavailablePause.String()).Warn(reason + ", pausing iterator")
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(unavailablePause):
+	}
+}
+
 func (c *CheckpointIterator) getLookAheads(ctx context.Context, location *xatu.CannonLocation) []*xatu.CannonLocation {
 	// Calculate if we should look ahead
-	epoch, err := c.getEpochFromLocation(location)
+	epoch, err := EpochFromLocation(location)
 	if err != nil {
 		return []*xatu.CannonLocation{}
 	}
@@ -156,7 +342,7 @@ func (c *CheckpointIterator) getLookAheads(ctx context.Context, location *xatu.C
 	for _, i := range []int{1, 2, 3} {
 		lookAheadEpoch := epoch + phase0.Epoch(i)
 
-		if lookAheadEpoch > latestCheckpoint.Epoch {
+		if lookAheadEpoch > c.targetEpoch(latestCheckpoint) {
 			continue
 		}
 
@@ -171,6 +357,20 @@ func (c *CheckpointIterator) getLookAheads(ctx context.Context, location *xatu.C
 	return lookAheads
 }
 
+// targetEpoch is the epoch the iterator advances up to, which is the checkpoint minus the configured offset.
+func (c *CheckpointIterator) targetEpoch(checkpoint *phase0.Checkpoint) phase0.Epoch {
+	if c.config == nil || c.config.FinalizedOffsetEpochs == 0 {
+		return checkpoint.Epoch
+	}
+
+	offset := phase0.Epoch(c.config.FinalizedOffsetEpochs)
+	if checkpoint.Epoch < offset {
+		return 0
+	}
+
+	return checkpoint.Epoch - offset
+}
+
 func (c *CheckpointIterator) fetchLatestEpoch(ctx context.Context) (*phase0.Checkpoint, error) {
 	_, span := observability.Tracer().Start(ctx,
 		"CheckpointIterator.FetchLatestEpoch",
@@ -179,11 +379,7 @@ func (c *CheckpointIterator) fetchLatestEpoch(ctx context.Context) (*phase0.Chec
 
 	finality, err := c.beaconNode.Node().Finality()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to fetch finality")
-	}
-
-	if c.checkpointName == "justified" {
-		return finality.Justified, nil
+		return nil, ethereum.NewNodeUnavailableError(errors.Wrap(err, "failed to fetch finality"))
 	}
 
 	if c.checkpointName == "finalized" {
@@ -193,7 +389,8 @@ func (c *CheckpointIterator) fetchLatestEpoch(ctx context.Context) (*phase0.Chec
 	return nil, errors.Errorf("unknown checkpoint name %s", c.checkpointName)
 }
 
-func (c *CheckpointIterator) getEpochFromLocation(location *xatu.CannonLocation) (phase0.Epoch, error) {
+// EpochFromLocation returns the epoch stored in a location of any epoch based cannon type.
+func EpochFromLocation(location *xatu.CannonLocation) (phase0.Epoch, error) {
 	switch location.Type {
 	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTER_SLASHING:
 		return phase0.Epoch(location.GetEthV2BeaconBlockAttesterSlashing().Epoch), nil
@@ -213,6 +410,22 @@ func (c *CheckpointIterator) getEpochFromLocation(location *xatu.CannonLocation)
 		return phase0.Epoch(location.GetEthV2BeaconBlock().Epoch), nil
 	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR:
 		return phase0.Epoch(location.GetEthV1BeaconBlobSidecar().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE:
+		return phase0.Epoch(location.GetEthV2BeaconBlockSyncAggregate().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY:
+		return phase0.Epoch(location.GetEthV2BeaconBlockSummary().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD:
+		return phase0.Epoch(location.GetEthV1BeaconBlockReward().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION:
+		return phase0.Epoch(location.GetEthV2BeaconBlockAttestation().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD:
+		return phase0.Epoch(location.GetEthV2BeaconBlockExecutionPayload().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY:
+		return phase0.Epoch(location.GetEthV1BeaconValidatorActivity().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY:
+		return phase0.Epoch(location.GetEthV1ProposerDuty().Epoch), nil
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE:
+		return phase0.Epoch(location.GetEthV1BeaconCommittee().Epoch), nil
 	default:
 		return 0, errors.Errorf("unknown cannon type %s", location.Type)
 	}
@@ -279,6 +492,54 @@ func (c *CheckpointIterator) createLocationFromEpochNumber(epoch phase0.Epoch) (
 				Epoch: uint64(epoch),
 			},
 		}
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE:
+		location.Data = &xatu.CannonLocation_EthV2BeaconBlockSyncAggregate{
+			EthV2BeaconBlockSyncAggregate: &xatu.CannonLocationEthV2BeaconBlockSyncAggregate{
+				Epoch: uint64(epoch),
+			},
+		}
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY:
+		location.Data = &xatu.CannonLocation_EthV2BeaconBlockSummary{
+			EthV2BeaconBlockSummary: &xatu.CannonLocationEthV2BeaconBlockSummary{
+				Epoch: uint64(epoch),
+			},
+		}
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD:
+		location.Data = &xatu.CannonLocation_EthV1BeaconBlockReward{
+			EthV1BeaconBlockReward: &xatu.CannonLocationEthV1BeaconBlockReward{
+				Epoch: uint64(epoch),
+			},
+		}
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION:
+		location.Data = &xatu.CannonLocation_EthV2BeaconBlockAttestation{
+			EthV2BeaconBlockAttestation: &xatu.CannonLocationEthV2BeaconBlockAttestation{
+				Epoch: uint64(epoch),
+			},
+		}
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD:
+		location.Data = &xatu.CannonLocation_EthV2BeaconBlockExecutionPayload{
+			EthV2BeaconBlockExecutionPayload: &xatu.CannonLocationEthV2BeaconBlockExecutionPayload{
+				Epoch: uint64(epoch),
+			},
+		}
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY:
+		location.Data = &xatu.CannonLocation_EthV1BeaconValidatorActivity{
+			EthV1BeaconValidatorActivity: &xatu.CannonLocationEthV1BeaconValidatorActivity{
+				Epoch: uint64(epoch),
+			},
+		}
+	case xatu.CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY:
+		location.Data = &xatu.CannonLocation_EthV1ProposerDuty{
+			EthV1ProposerDuty: &xatu.CannonLocationEthV1ProposerDuty{
+				Epoch: uint64(epoch),
+			},
+		}
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE:
+		location.Data = &xatu.CannonLocation_EthV1BeaconCommittee{
+			EthV1BeaconCommittee: &xatu.CannonLocationEthV1BeaconCommittee{
+				Epoch: uint64(epoch),
+			},
+		}
 	default:
 		return location, errors.Errorf("unknown cannon type %s", location.Type)
 	}
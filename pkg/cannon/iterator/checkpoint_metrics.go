@@ -7,7 +7,7 @@ type CheckpointMetrics struct {
 	Currentepoch   *prometheus.GaugeVec
 }
 
-func NewCheckpointMetrics(namespace string) CheckpointMetrics {
+func NewCheckpointMetrics(namespace string, registerer prometheus.Registerer) CheckpointMetrics {
 	namespace += "_epoch_iterator"
 
 	s := CheckpointMetrics{
@@ -23,8 +23,8 @@ func NewCheckpointMetrics(namespace string) CheckpointMetrics {
 		}, []string{"cannon_type", "network", "checkpoint"}),
 	}
 
-	prometheus.MustRegister(s.Trailingepochs)
-	prometheus.MustRegister(s.Currentepoch)
+	registerer.MustRegister(s.Trailingepochs)
+	registerer.MustRegister(s.Currentepoch)
 
 	return s
 }
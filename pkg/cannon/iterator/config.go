@@ -0,0 +1,12 @@
+package iterator
+
+// CheckpointConfig holds the settings shared by the checkpoint iterators of all derivers.
+type CheckpointConfig struct {
+	// MaxSlotsPerRound limits how many slots each deriver may advance per slot of wall clock time, so derivers
+	// catch up at a similar pace instead of one monopolising the beacon node. Derivers advance a whole epoch at a
+	// time, so this is rounded down to whole epochs, with a minimum of one. Zero means unlimited.
+	MaxSlotsPerRound uint64 `yaml:"maxSlotsPerRound" default:"0"`
+	// FinalizedOffsetEpochs keeps the iterators this many epochs behind the checkpoint, trading freshness for
+	// protection against reorgs past the checkpoint.
+	FinalizedOffsetEpochs uint64 `yaml:"finalizedOffsetEpochs" default:"0"`
+}
@@ -23,6 +23,21 @@ var (
 	SlotZero = phase0.Slot(0)
 )
 
+// LocationValue returns the slot of a blockprint location, or the epoch of any other location. This is the
+// value derivers report when their location is updated.
+func LocationValue(location *xatu.CannonLocation) (uint64, error) {
+	if location.GetType() == xatu.CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION {
+		return location.GetBlockprintBlockClassification().GetSlot(), nil
+	}
+
+	epoch, err := EpochFromLocation(location)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(epoch), nil
+}
+
 func GetDefaultSlotLocation(forkEpochs state.ForkEpochs, cannonType xatu.CannonType) phase0.Slot {
 	defaults := NewSlotDefaultsFromForkEpochs(forkEpochs)
 	if slot, exists := defaults[cannonType]; exists {
@@ -31,3 +46,19 @@ func GetDefaultSlotLocation(forkEpochs state.ForkEpochs, cannonType xatu.CannonT
 
 	return SlotZero
 }
+
+// WithStop returns a copy of ctx that is also cancelled once stop is done. Derivers use it so a blocking Next
+// returns as soon as the deriver is stopped. The returned cancel func must always be called.
+func WithStop(ctx, stop context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		select {
+		case <-stop.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
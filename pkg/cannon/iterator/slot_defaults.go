@@ -8,11 +8,17 @@ import (
 
 func NewSlotDefaultsFromForkEpochs(forkEpochs state.ForkEpochs) map[xatu.CannonType]phase0.Slot {
 	var (
+		altairEpoch,
 		bellatrixEpoch,
 		capellaEpoch,
 		denebEpoch phase0.Epoch
 	)
 
+	altair, err := forkEpochs.GetByName("ALTAIR")
+	if err == nil {
+		altairEpoch = altair.Epoch
+	}
+
 	bellatrix, err := forkEpochs.GetByName("BELLATRIX")
 	if err == nil {
 		bellatrixEpoch = bellatrix.Epoch
@@ -33,5 +39,7 @@ func NewSlotDefaultsFromForkEpochs(forkEpochs state.ForkEpochs) map[xatu.CannonT
 		xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_WITHDRAWAL:              phase0.Slot(capellaEpoch * 32),
 		xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_TRANSACTION:   phase0.Slot(bellatrixEpoch * 32),
 		xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR:                  phase0.Slot(denebEpoch * 32),
+		xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE:          phase0.Slot(altairEpoch * 32),
+		xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD:       phase0.Slot(bellatrixEpoch * 32),
 	}
 }
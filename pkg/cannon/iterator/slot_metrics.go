@@ -7,7 +7,7 @@ type SlotMetrics struct {
 	CurrentSlot   *prometheus.GaugeVec
 }
 
-func NewSlotMetrics(namespace string) SlotMetrics {
+func NewSlotMetrics(namespace string, registerer prometheus.Registerer) SlotMetrics {
 	namespace += "_slot_iterator"
 
 	s := SlotMetrics{
@@ -23,8 +23,8 @@ func NewSlotMetrics(namespace string) SlotMetrics {
 		}, []string{"cannon_type", "network"}),
 	}
 
-	prometheus.MustRegister(s.TrailingSlots)
-	prometheus.MustRegister(s.CurrentSlot)
+	registerer.MustRegister(s.TrailingSlots)
+	registerer.MustRegister(s.CurrentSlot)
 
 	return s
 }
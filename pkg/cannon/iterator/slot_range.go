@@ -0,0 +1,58 @@
+package iterator
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SlotRange restricts a deriver to the slots between StartSlot and EndSlot (inclusive). The deriver stops once it
+// has moved past EndSlot. Without an EndSlot it keeps following the checkpoint after catching up.
+type SlotRange struct {
+	StartSlot *uint64 `yaml:"startSlot"`
+	EndSlot   *uint64 `yaml:"endSlot"`
+}
+
+func (r *SlotRange) Validate() error {
+	if r.EndSlot != nil && r.StartSlot == nil {
+		return errors.New("endSlot requires startSlot to be set")
+	}
+
+	if r.EndSlot != nil && *r.EndSlot < *r.StartSlot {
+		return errors.New("endSlot must not be before startSlot")
+	}
+
+	return nil
+}
+
+// Enabled returns true if the range has been configured.
+func (r *SlotRange) Enabled() bool {
+	return r != nil && r.StartSlot != nil
+}
+
+// Contains returns true if the slot is inside the range.
+func (r *SlotRange) Contains(slot uint64) bool {
+	if !r.Enabled() {
+		return true
+	}
+
+	if slot < *r.StartSlot {
+		return false
+	}
+
+	return r.EndSlot == nil || slot <= *r.EndSlot
+}
+
+// StartEpoch returns the epoch containing the start slot.
+func (r *SlotRange) StartEpoch(slotsPerEpoch uint64) phase0.Epoch {
+	return phase0.Epoch(*r.StartSlot / slotsPerEpoch)
+}
+
+// IsPastEnd returns true if every slot of the epoch is after the end slot.
+func (r *SlotRange) IsPastEnd(epoch phase0.Epoch, slotsPerEpoch uint64) bool {
+	if !r.Enabled() || r.EndSlot == nil {
+		return false
+	}
+
+	return epoch > phase0.Epoch(*r.EndSlot/slotsPerEpoch)
+}
@@ -0,0 +1,116 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func uint64Ptr(v uint64) *uint64 {
+	return &v
+}
+
+func slotEvent(slot uint64) *xatu.DecoratedEvent {
+	return &xatu.DecoratedEvent{
+		Event: &xatu.Event{Name: xatu.Event_BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT},
+		Meta: &xatu.Meta{
+			Client: &xatu.ClientMeta{
+				AdditionalData: &xatu.ClientMeta_EthV2BeaconBlockVoluntaryExit{
+					EthV2BeaconBlockVoluntaryExit: &xatu.ClientMeta_AdditionalEthV2BeaconBlockVoluntaryExitData{
+						Block: &xatu.BlockIdentifier{
+							Slot: &xatu.SlotV2{Number: wrapperspb.UInt64(slot)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSlotRangeValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		slotRange SlotRange
+		wantErr   bool
+	}{
+		{name: "unset", slotRange: SlotRange{}},
+		{name: "start only", slotRange: SlotRange{StartSlot: uint64Ptr(100)}},
+		{name: "single slot", slotRange: SlotRange{StartSlot: uint64Ptr(100), EndSlot: uint64Ptr(100)}},
+		{name: "end before start", slotRange: SlotRange{StartSlot: uint64Ptr(100), EndSlot: uint64Ptr(99)}, wantErr: true},
+		{name: "end without start", slotRange: SlotRange{EndSlot: uint64Ptr(100)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.slotRange.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSlotRangeBoundaries(t *testing.T) {
+	// Slots 40 to 100 start part way through epoch 1 and end part way through epoch 3.
+	r := SlotRange{StartSlot: uint64Ptr(40), EndSlot: uint64Ptr(100)}
+
+	assert.Equal(t, phase0.Epoch(1), r.StartEpoch(32))
+
+	assert.False(t, r.Contains(39))
+	assert.True(t, r.Contains(40))
+	assert.True(t, r.Contains(100))
+	assert.False(t, r.Contains(101))
+
+	assert.False(t, r.IsPastEnd(1, 32))
+	assert.False(t, r.IsPastEnd(3, 32))
+	assert.True(t, r.IsPastEnd(4, 32))
+
+	// The spec's slots per epoch decides which epoch holds the boundaries.
+	assert.Equal(t, phase0.Epoch(5), r.StartEpoch(8))
+	assert.False(t, r.IsPastEnd(12, 8))
+	assert.True(t, r.IsPastEnd(13, 8))
+}
+
+func TestSlotRangeWithoutEnd(t *testing.T) {
+	r := SlotRange{StartSlot: uint64Ptr(64)}
+
+	assert.False(t, r.Contains(63))
+	assert.True(t, r.Contains(64))
+	assert.True(t, r.Contains(1_000_000))
+	assert.False(t, r.IsPastEnd(1_000_000, 32))
+}
+
+func TestFilterSlotRange(t *testing.T) {
+	events := []*xatu.DecoratedEvent{slotEvent(39), slotEvent(40), slotEvent(100), slotEvent(101)}
+
+	c := &CheckpointIterator{log: logrus.New()}
+
+	// Without a range every event is kept.
+	assert.Equal(t, events, c.FilterSlotRange(events))
+
+	c.SetSlotRange(SlotRange{StartSlot: uint64Ptr(40), EndSlot: uint64Ptr(100)})
+
+	filtered := c.FilterSlotRange(events)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, events[1:3], filtered)
+
+	// Events that don't carry a slot are kept.
+	unslotted := &xatu.DecoratedEvent{Event: &xatu.Event{Name: xatu.Event_BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT}}
+	assert.Equal(t, []*xatu.DecoratedEvent{unslotted}, c.FilterSlotRange([]*xatu.DecoratedEvent{unslotted}))
+}
+
+func TestSetSlotRangeIgnoresUnsetRange(t *testing.T) {
+	c := &CheckpointIterator{log: logrus.New()}
+
+	c.SetSlotRange(SlotRange{})
+
+	assert.Nil(t, c.slotRange)
+	assert.False(t, c.isPastEndSlot(1_000_000))
+}
@@ -0,0 +1,50 @@
+package cannon
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// expandLabels resolves environment variables in the label values. ${VAR} is required to be set while
+// ${VAR:-default} falls back to the default when VAR is unset or empty.
+func expandLabels(labels map[string]string) (map[string]string, error) {
+	if labels == nil {
+		return nil, nil
+	}
+
+	expanded := make(map[string]string, len(labels))
+	missing := make(map[string]struct{})
+
+	for key, value := range labels {
+		expanded[key] = os.Expand(value, func(name string) string {
+			name, fallback, hasFallback := strings.Cut(name, ":-")
+
+			if resolved := os.Getenv(name); resolved != "" {
+				return resolved
+			}
+
+			if hasFallback {
+				return fallback
+			}
+
+			missing[name] = struct{}{}
+
+			return ""
+		})
+	}
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("unresolved environment variables in labels: %s", strings.Join(names, ", "))
+	}
+
+	return expanded, nil
+}
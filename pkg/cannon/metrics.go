@@ -1,24 +1,107 @@
 package cannon
 
 import (
+	"time"
+
 	"github.com/ethpandaops/xatu/pkg/proto/xatu"
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 )
 
 type Metrics struct {
 	decoratedEventTotal *prometheus.CounterVec
+	droppedEventTotal   *prometheus.CounterVec
+	deadLetterTotal     *prometheus.CounterVec
+	deadLetterFailed    *prometheus.CounterVec
+	sinkDroppedTotal    *prometheus.CounterVec
+	deriverLocation     *prometheus.GaugeVec
+	deriverLagSlots     *prometheus.GaugeVec
+	deriverEventsTotal  *prometheus.CounterVec
+	sinkBusyTotal       *prometheus.CounterVec
+	deriverPaused       *prometheus.GaugeVec
+	ntpQueryTotal       *prometheus.CounterVec
+	ntpClockOffset      prometheus.Gauge
+
+	startedAt time.Time
 }
 
-func NewMetrics(namespace string) *Metrics {
+func NewMetrics(namespace string, registerer prometheus.Registerer) *Metrics {
 	m := &Metrics{
+		startedAt: time.Now(),
 		decoratedEventTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Name:      "decorated_event_total",
 			Help:      "Total number of decorated events created by the cannon",
 		}, []string{"type", "network"}),
+		droppedEventTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "decorated_event_dropped_total",
+			Help:      "Total number of decorated events that did not match any sink",
+		}, []string{"type", "network"}),
+		deadLetterTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "dead_letter_event_total",
+			Help:      "Total number of decorated events sent to the dead-letter sink",
+		}, []string{"sink", "network"}),
+		deadLetterFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "dead_letter_failed_event_total",
+			Help:      "Total number of decorated events the dead-letter sink failed to handle",
+		}, []string{"sink", "network"}),
+		sinkDroppedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "events_dropped_total",
+			Help:      "Total number of decorated events lost because a sink permanently failed to handle them",
+		}, []string{"sink_type", "type", "network"}),
+		deriverLocation: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "deriver_location",
+			Help:      "The current location of the deriver",
+		}, []string{"type", "network"}),
+		deriverLagSlots: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "deriver_lag_slots",
+			Help:      "The number of slots the deriver is behind the finalized checkpoint",
+		}, []string{"type", "network"}),
+		deriverEventsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "deriver_events_total",
+			Help:      "Total number of decorated events emitted by each deriver",
+		}, []string{"type", "network"}),
+		sinkBusyTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "sink_busy_total",
+			Help:      "Total number of times a sink rejected events because it was busy",
+		}, []string{"sink", "network"}),
+		deriverPaused: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "deriver_paused",
+			Help:      "1 if the deriver has been paused through the control endpoint, 0 otherwise",
+		}, []string{"type"}),
+		ntpQueryTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "ntp_query_total",
+			Help:      "Total number of NTP queries made to measure clock drift",
+		}, []string{"server", "result"}),
+		ntpClockOffset: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "ntp_clock_offset_seconds",
+			Help:      "The clock offset last measured against an NTP server",
+		}),
 	}
 
-	prometheus.MustRegister(m.decoratedEventTotal)
+	registerer.MustRegister(m.decoratedEventTotal)
+	registerer.MustRegister(m.droppedEventTotal)
+	registerer.MustRegister(m.deadLetterTotal)
+	registerer.MustRegister(m.deadLetterFailed)
+	registerer.MustRegister(m.sinkDroppedTotal)
+	registerer.MustRegister(m.deriverLocation)
+	registerer.MustRegister(m.deriverLagSlots)
+	registerer.MustRegister(m.deriverEventsTotal)
+	registerer.MustRegister(m.sinkBusyTotal)
+	registerer.MustRegister(m.deriverPaused)
+	registerer.MustRegister(m.ntpQueryTotal)
+	registerer.MustRegister(m.ntpClockOffset)
 
 	return m
 }
@@ -26,3 +109,94 @@ func NewMetrics(namespace string) *Metrics {
 func (m *Metrics) AddDecoratedEvent(count int, eventType *xatu.DecoratedEvent, network string) {
 	m.decoratedEventTotal.WithLabelValues(eventType.Event.Name.String(), network).Add(float64(count))
 }
+
+func (m *Metrics) AddDroppedDecoratedEvent(count int, eventType *xatu.DecoratedEvent, network string) {
+	m.droppedEventTotal.WithLabelValues(eventType.Event.Name.String(), network).Add(float64(count))
+}
+
+func (m *Metrics) AddDeadLetteredEvents(count int, sink, network string) {
+	m.deadLetterTotal.WithLabelValues(sink, network).Add(float64(count))
+}
+
+func (m *Metrics) AddDeadLetterFailedEvents(count int, sink, network string) {
+	m.deadLetterFailed.WithLabelValues(sink, network).Add(float64(count))
+}
+
+func (m *Metrics) AddSinkDroppedEvent(count int, sinkType string, eventType *xatu.DecoratedEvent, network string) {
+	m.sinkDroppedTotal.WithLabelValues(sinkType, eventType.Event.Name.String(), network).Add(float64(count))
+}
+
+func (m *Metrics) SetDeriverLocation(location uint64, cannonType xatu.CannonType, network string) {
+	m.deriverLocation.WithLabelValues(cannonType.String(), network).Set(float64(location))
+}
+
+func (m *Metrics) AddSinkBusy(sink, network string) {
+	m.sinkBusyTotal.WithLabelValues(sink, network).Inc()
+}
+
+func (m *Metrics) SetDeriverPaused(name string, paused bool) {
+	value := 0.0
+	if paused {
+		value = 1
+	}
+
+	m.deriverPaused.WithLabelValues(name).Set(value)
+}
+
+func (m *Metrics) AddNTPQuery(server string, success bool) {
+	result := "failure"
+	if success {
+		result = "success"
+	}
+
+	m.ntpQueryTotal.WithLabelValues(server, result).Inc()
+}
+
+func (m *Metrics) SetNTPClockOffset(offset time.Duration) {
+	m.ntpClockOffset.Set(offset.Seconds())
+}
+
+func (m *Metrics) SetDeriverLagSlots(lag uint64, cannonType xatu.CannonType, network string) {
+	m.deriverLagSlots.WithLabelValues(cannonType.String(), network).Set(float64(lag))
+}
+
+func (m *Metrics) AddDeriverEvents(count int, cannonType xatu.CannonType, network string) {
+	m.deriverEventsTotal.WithLabelValues(cannonType.String(), network).Add(float64(count))
+}
+
+// DeriverEventCounts reads the deriver_events_total counter back, summed across networks and keyed by cannon type.
+func (m *Metrics) DeriverEventCounts() (map[string]uint64, error) {
+	ch := make(chan prometheus.Metric)
+
+	go func() {
+		m.deriverEventsTotal.Collect(ch)
+		close(ch)
+	}()
+
+	counts := map[string]uint64{}
+
+	var err error
+
+	for metric := range ch {
+		pb := &dto.Metric{}
+
+		// Keep draining the channel on error so the collecting goroutine can finish.
+		if werr := metric.Write(pb); werr != nil {
+			err = werr
+
+			continue
+		}
+
+		for _, label := range pb.GetLabel() {
+			if label.GetName() == "type" {
+				counts[label.GetValue()] += uint64(pb.GetCounter().GetValue())
+			}
+		}
+	}
+
+	return counts, err
+}
+
+func (m *Metrics) StartedAt() time.Time {
+	return m.startedAt
+}
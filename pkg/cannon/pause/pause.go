@@ -0,0 +1,114 @@
+package pause
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// Gate holds a deriver before its next location while paused.
+type Gate struct {
+	mu      sync.Mutex
+	paused  bool
+	resumed chan struct{}
+}
+
+func (g *Gate) Pause() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.paused {
+		return
+	}
+
+	g.paused = true
+	g.resumed = make(chan struct{})
+}
+
+func (g *Gate) Resume() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.paused {
+		return
+	}
+
+	g.paused = false
+	close(g.resumed)
+}
+
+func (g *Gate) Paused() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.paused
+}
+
+// Wait blocks while the gate is paused. Waiting is abandoned when ctx is done.
+func (g *Gate) Wait(ctx context.Context) error {
+	g.mu.Lock()
+
+	if !g.paused {
+		g.mu.Unlock()
+
+		return nil
+	}
+
+	resumed := g.resumed
+
+	g.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-resumed:
+		return nil
+	}
+}
+
+// Controller keeps a gate per deriver name. Gates outlive the derivers, so a deriver recreated on reload
+// keeps its paused state.
+type Controller struct {
+	mu    sync.Mutex
+	gates map[string]*Gate
+}
+
+func NewController() *Controller {
+	return &Controller{
+		gates: make(map[string]*Gate),
+	}
+}
+
+// Gate returns the gate of the named deriver, creating it if needed.
+func (c *Controller) Gate(name string) *Gate {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	gate, ok := c.gates[name]
+	if !ok {
+		gate = &Gate{}
+		c.gates[name] = gate
+	}
+
+	return gate
+}
+
+// States returns whether each deriver with a gate is paused.
+func (c *Controller) States() map[string]bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	names := make([]string, 0, len(c.gates))
+	for name := range c.gates {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	states := make(map[string]bool, len(names))
+	for _, name := range names {
+		states[name] = c.gates[name].Paused()
+	}
+
+	return states
+}
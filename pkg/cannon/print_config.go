@@ -0,0 +1,88 @@
+package cannon
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+const redacted = "<redacted>"
+
+var secretKeyPattern = regexp.MustCompile(`(?i)(password|secret|token|authorization|apikey|api_key|credential|dsn)`)
+
+// RedactedYAML returns the config as YAML with anything that looks like a credential replaced, so the
+// effective config can be shared when debugging.
+func (c *Config) RedactedYAML() ([]byte, error) {
+	raw, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(redactSecrets(doc))
+}
+
+func redactSecrets(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, inner := range value {
+			if isSecretKey(k) {
+				if inner != nil && inner != "" {
+					value[k] = redacted
+				}
+
+				continue
+			}
+
+			value[k] = redactSecrets(inner)
+		}
+
+		return value
+	case []interface{}:
+		for i, inner := range value {
+			value[i] = redactSecrets(inner)
+		}
+
+		return value
+	case string:
+		return redactURLPassword(value)
+	default:
+		return v
+	}
+}
+
+// isSecretKey reports whether the key holds a secret. Keys that only point at where a secret lives,
+// such as bearerTokenFile, are kept.
+func isSecretKey(key string) bool {
+	lower := strings.ToLower(key)
+	if strings.HasSuffix(lower, "env") || strings.HasSuffix(lower, "file") || strings.HasSuffix(lower, "path") {
+		return false
+	}
+
+	return secretKeyPattern.MatchString(key)
+}
+
+func redactURLPassword(s string) string {
+	if !strings.Contains(s, "@") || !strings.Contains(s, "://") {
+		return s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+
+	if _, ok := u.User.Password(); !ok {
+		return s
+	}
+
+	u.User = url.UserPassword(u.User.Username(), redacted)
+
+	return u.String()
+}
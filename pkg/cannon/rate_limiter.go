@@ -0,0 +1,66 @@
+package cannon
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// eventRateLimiter is a token bucket shared by all derivers. Callers reserve tokens up front, so a caller that
+// drives the bucket negative waits for the deficit to refill and later callers queue up behind it.
+type eventRateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newEventRateLimiter(eventsPerSecond int) *eventRateLimiter {
+	return &eventRateLimiter{
+		rate:   float64(eventsPerSecond),
+		burst:  float64(eventsPerSecond),
+		tokens: float64(eventsPerSecond),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until n events may be emitted or the context is cancelled.
+func (l *eventRateLimiter) Wait(ctx context.Context, n int) error {
+	for remaining := float64(n); remaining > 0; {
+		take := math.Min(remaining, l.burst)
+		remaining -= take
+
+		if wait := l.reserve(take); wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	return nil
+}
+
+func (l *eventRateLimiter) reserve(n float64) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens -= n
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
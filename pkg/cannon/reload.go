@@ -0,0 +1,135 @@
+package cannon
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/ethwallclock"
+	"github.com/ethpandaops/xatu/pkg/cannon/circuitbreaker"
+	"github.com/ethpandaops/xatu/pkg/cannon/dedup"
+	"github.com/ethpandaops/xatu/pkg/cannon/deriver"
+	v2 "github.com/ethpandaops/xatu/pkg/cannon/deriver/beacon/eth/v2"
+	"github.com/ethpandaops/xatu/pkg/cannon/iterator"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	perrors "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// deriverDeps holds the state every event deriver is built from. It is captured once the beacon node is
+// ready and reused when derivers are started by a config reload, so the iterator metrics are only
+// registered once.
+type deriverDeps struct {
+	networkName string
+	networkID   string
+	wallclock   *ethwallclock.EthereumBeaconChain
+	nodeVersion string
+
+	checkpointIteratorMetrics   iterator.CheckpointMetrics
+	blockprintIteratorMetrics   iterator.BlockprintMetrics
+	circuitBreakerMetrics       *circuitbreaker.Metrics
+	dedupMetrics                *dedup.Metrics
+	executionTransactionMetrics *v2.Metrics
+}
+
+// SetConfigLoader enables reloading the deriver enable flags on SIGHUP. The loader is expected to
+// re-read the same config file the cannon was started with.
+func (c *Cannon) SetConfigLoader(loader func() (*Config, error)) {
+	c.configLoader = loader
+}
+
+// reloadDerivers reconciles the running derivers with the enable flags in a freshly loaded config.
+// Newly enabled derivers are started and newly disabled ones are stopped; derivers that stay enabled
+// keep running untouched, along with their in-memory progress and config.
+func (c *Cannon) reloadDerivers(ctx context.Context) error {
+	if c.configLoader == nil {
+		c.log.Warn("Received SIGHUP but config reloading is not available")
+
+		return nil
+	}
+
+	c.log.Info("Received SIGHUP, reloading deriver config")
+
+	config, err := c.configLoader()
+	if err != nil {
+		return perrors.Wrap(err, "failed to load config")
+	}
+
+	if err := config.Derivers.Validate(); err != nil {
+		return perrors.Wrap(err, "invalid derivers config")
+	}
+
+	c.eventDeriversMu.Lock()
+	defer c.eventDeriversMu.Unlock()
+
+	if c.deriverDeps == nil {
+		return errors.New("event derivers have not been started yet")
+	}
+
+	// Running derivers hold pointers into c.Config.Derivers, so newly enabled derivers are built
+	// from their own copy of the reloaded config instead of overwriting it in place.
+	next := config.Derivers
+
+	candidates := c.createEventDerivers(&next)
+
+	if err := deriver.CheckUniqueCannonTypes(candidates); err != nil {
+		return err
+	}
+
+	enabled := make(map[xatu.CannonType]bool, len(candidates))
+	for _, d := range candidates {
+		enabled[d.CannonType()] = true
+	}
+
+	stopCtx, cancel := context.WithTimeout(ctx, deriverShutdownTimeout)
+	defer cancel()
+
+	running := make([]deriver.EventDeriver, 0, len(candidates))
+	alreadyRunning := make(map[xatu.CannonType]bool, len(c.eventDerivers))
+
+	started, stopped := 0, 0
+
+	for _, d := range c.eventDerivers {
+		if enabled[d.CannonType()] {
+			running = append(running, d)
+			alreadyRunning[d.CannonType()] = true
+
+			continue
+		}
+
+		c.log.
+			WithField("deriver", d.Name()).
+			WithField("type", d.CannonType()).
+			Info("Stopping disabled cannon event deriver")
+
+		if err := d.Stop(stopCtx); err != nil {
+			c.log.WithError(err).WithField("deriver", d.Name()).Warn("Failed to gracefully stop deriver")
+		}
+
+		stopped++
+	}
+
+	for _, d := range candidates {
+		if alreadyRunning[d.CannonType()] {
+			continue
+		}
+
+		if err := c.startEventDeriver(ctx, &next, d); err != nil {
+			c.eventDerivers = running
+
+			return perrors.Wrapf(err, "failed to start deriver %s", d.Name())
+		}
+
+		running = append(running, d)
+		started++
+	}
+
+	c.eventDerivers = running
+
+	c.log.WithFields(logrus.Fields{
+		"running": len(running),
+		"started": started,
+		"stopped": stopped,
+	}).Info("Reloaded deriver config")
+
+	return nil
+}
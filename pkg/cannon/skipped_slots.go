@@ -0,0 +1,18 @@
+package cannon
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// handleSkippedSlots lists the slots skipped for exceeding their deriver's slotDeadline, so they
+// can be reprocessed. Filter by deriver with ?type=<cannon type>.
+func (c *Cannon) handleSkippedSlots(w http.ResponseWriter, r *http.Request) {
+	slots := c.slotDeadlines.SkippedSlots(r.URL.Query().Get("type"))
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(slots); err != nil {
+		c.log.WithError(err).Debug("Failed to write skipped slots response")
+	}
+}
@@ -0,0 +1,47 @@
+package cannon
+
+import (
+	"github.com/ethpandaops/xatu/pkg/cannon/deriver"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+type deriverSummary struct {
+	Name       string `json:"name"`
+	CannonType string `json:"cannonType"`
+	Iterator   string `json:"iterator"`
+}
+
+func iteratorTypeFor(cannonType xatu.CannonType) string {
+	if cannonType == xatu.CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION {
+		return "blockprint"
+	}
+
+	return "checkpoint"
+}
+
+// logDeriverSummary logs a single structured record of what this cannon instance is configured to produce, so
+// gaps in a dataset can later be matched against the configuration at the time.
+func (c *Cannon) logDeriverSummary(derivers []deriver.EventDeriver, networkName, networkID string) {
+	summaries := make([]deriverSummary, 0, len(derivers))
+
+	for _, d := range derivers {
+		summaries = append(summaries, deriverSummary{
+			Name:       d.Name(),
+			CannonType: d.CannonType().String(),
+			Iterator:   iteratorTypeFor(d.CannonType()),
+		})
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"record":                  "cannon_startup",
+		"cannon_name":             c.Config.Name,
+		"network":                 networkName,
+		"network_id":              networkID,
+		"derivers":                summaries,
+		"derivers_enabled":        len(summaries),
+		"dry_run":                 c.Config.DryRun,
+		"max_slots_per_round":     c.Config.Derivers.Iterator.MaxSlotsPerRound,
+		"finalized_offset_epochs": c.Config.Derivers.Iterator.FinalizedOffsetEpochs,
+	}).Info("Cannon deriver configuration")
+}
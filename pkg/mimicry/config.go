@@ -65,12 +65,13 @@ func (c *Config) CreateSinks(log logrus.FieldLogger) ([]output.Sink, error) {
 			log,
 			out.FilterConfig,
 			processor.ShippingMethodAsync,
+			"",
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		sinks[i] = sink
+		sinks[i] = output.WithBatching(sink, out.Batch, log)
 	}
 
 	return sinks, nil
@@ -0,0 +1,33 @@
+package observability
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogFormat is the format logs are written in.
+type LogFormat string
+
+const (
+	LogFormatText LogFormat = "text"
+	LogFormatJSON LogFormat = "json"
+)
+
+func (f LogFormat) Validate() error {
+	switch f {
+	case "", LogFormatText, LogFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("unsupported log format %q, must be %s or %s", f, LogFormatText, LogFormatJSON)
+	}
+}
+
+// Formatter returns the logrus formatter for the format. Empty and unknown formats are written as text.
+func (f LogFormat) Formatter() logrus.Formatter {
+	if f == LogFormatJSON {
+		return &logrus.JSONFormatter{}
+	}
+
+	return &logrus.TextFormatter{}
+}
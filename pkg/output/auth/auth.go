@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config configures the bearer token a sink sends with every request. The token can be set inline, or loaded
+// from an environment variable or a file so it doesn't have to be committed to the config.
+type Config struct {
+	BearerToken     string `yaml:"bearerToken"`
+	BearerTokenEnv  string `yaml:"bearerTokenEnv"`
+	BearerTokenFile string `yaml:"bearerTokenFile"`
+}
+
+func (c *Config) Validate() error {
+	set := 0
+
+	for _, v := range []string{c.BearerToken, c.BearerTokenEnv, c.BearerTokenFile} {
+		if v != "" {
+			set++
+		}
+	}
+
+	if set > 1 {
+		return errors.New("only one of bearerToken, bearerTokenEnv and bearerTokenFile can be set")
+	}
+
+	return nil
+}
+
+// Enabled returns true if a bearer token has been configured.
+func (c *Config) Enabled() bool {
+	return c.BearerToken != "" || c.BearerTokenEnv != "" || c.BearerTokenFile != ""
+}
+
+// Token resolves the bearer token. The file is read on every call so rotated tokens are picked up.
+func (c *Config) Token() (string, error) {
+	switch {
+	case c.BearerToken != "":
+		return c.BearerToken, nil
+	case c.BearerTokenEnv != "":
+		token, ok := os.LookupEnv(c.BearerTokenEnv)
+		if !ok || token == "" {
+			return "", fmt.Errorf("environment variable %s is not set", c.BearerTokenEnv)
+		}
+
+		return token, nil
+	case c.BearerTokenFile != "":
+		data, err := os.ReadFile(c.BearerTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read bearer token file: %w", err)
+		}
+
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", fmt.Errorf("bearer token file %s is empty", c.BearerTokenFile)
+		}
+
+		return token, nil
+	}
+
+	return "", nil
+}
+
+// AuthorizationHeader returns the value of the Authorization header, or an empty string if no token is configured.
+func (c *Config) AuthorizationHeader() (string, error) {
+	if !c.Enabled() {
+		return "", nil
+	}
+
+	token, err := c.Token()
+	if err != nil {
+		return "", err
+	}
+
+	return "Bearer " + token, nil
+}
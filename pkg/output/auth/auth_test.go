@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizationHeader(t *testing.T) {
+	t.Setenv("XATU_TEST_TOKEN", "from-env")
+
+	file := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(file, []byte("from-file\n"), 0o600))
+
+	tests := []struct {
+		name     string
+		config   Config
+		expected string
+		wantErr  bool
+	}{
+		{name: "disabled", config: Config{}, expected: ""},
+		{name: "inline", config: Config{BearerToken: "inline"}, expected: "Bearer inline"},
+		{name: "env", config: Config{BearerTokenEnv: "XATU_TEST_TOKEN"}, expected: "Bearer from-env"},
+		{name: "missing env", config: Config{BearerTokenEnv: "XATU_TEST_MISSING"}, wantErr: true},
+		{name: "file", config: Config{BearerTokenFile: file}, expected: "Bearer from-file"},
+		{name: "missing file", config: Config{BearerTokenFile: file + ".missing"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, err := tt.config.AuthorizationHeader()
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, header)
+		})
+	}
+}
+
+func TestValidateRejectsMultipleSources(t *testing.T) {
+	config := Config{BearerToken: "a", BearerTokenFile: "/tmp/token"}
+
+	assert.Error(t, config.Validate())
+}
@@ -0,0 +1,263 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+type BatchConfig struct {
+	// MaxBatchSize is the number of events buffered before they are flushed to the sink. 0 disables batching.
+	MaxBatchSize int `yaml:"maxBatchSize" default:"0"`
+	// FlushInterval is the maximum time an event is buffered before it is flushed to the sink.
+	FlushInterval time.Duration `yaml:"flushInterval" default:"1s"`
+	// FlushTimeout bounds how long a single interval flush may take before it is abandoned and retried on the
+	// next interval.
+	FlushTimeout time.Duration `yaml:"flushTimeout" default:"30s"`
+	// MaxBufferSize is the maximum number of events buffered while the sink is failing to keep up. Once reached,
+	// new events are rejected with ErrSinkBusy until the buffer drains.
+	MaxBufferSize int `yaml:"maxBufferSize" default:"51200"`
+}
+
+func (c *BatchConfig) Validate() error {
+	if c.MaxBatchSize < 0 {
+		return errors.New("maxBatchSize must be greater than or equal to 0")
+	}
+
+	if c.MaxBatchSize > 0 && c.FlushInterval <= 0 {
+		return errors.New("flushInterval must be greater than 0")
+	}
+
+	if c.MaxBatchSize > 0 && c.FlushTimeout <= 0 {
+		return errors.New("flushTimeout must be greater than 0")
+	}
+
+	if c.MaxBatchSize > 0 && c.MaxBufferSize < c.MaxBatchSize {
+		return errors.New("maxBufferSize must be greater than or equal to maxBatchSize")
+	}
+
+	return nil
+}
+
+func (c *BatchConfig) Enabled() bool {
+	return c.MaxBatchSize > 0
+}
+
+// RejectedHandler is handed batches the sink permanently rejected, along with the sink's error.
+type RejectedHandler func(ctx context.Context, events []*xatu.DecoratedEvent, err error)
+
+// BatchedSink buffers events in front of another sink and flushes them once
+// either MaxBatchSize events are buffered or FlushInterval has elapsed.
+type BatchedSink struct {
+	Sink
+
+	config BatchConfig
+	log    logrus.FieldLogger
+
+	// onRejected receives permanently rejected batches. They are dropped when it is nil.
+	onRejected RejectedHandler
+
+	// mu guards the buffer. flushMu is held while sending to the sink so only one flush removes events from the
+	// front of the buffer at a time, without blocking callers appending to it.
+	mu      sync.Mutex
+	flushMu sync.Mutex
+	buffer  []*xatu.DecoratedEvent
+
+	started  atomic.Bool
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// WithBatching wraps the sink in a BatchedSink if batching is enabled,
+// otherwise the sink is returned as is.
+func WithBatching(sink Sink, config BatchConfig, log logrus.FieldLogger) Sink {
+	if !config.Enabled() {
+		return sink
+	}
+
+	return NewBatchedSink(sink, config, log)
+}
+
+func NewBatchedSink(sink Sink, config BatchConfig, log logrus.FieldLogger) *BatchedSink {
+	return &BatchedSink{
+		Sink:   sink,
+		config: config,
+		log:    log.WithField("output_name", sink.Name()).WithField("output_type", sink.Type()),
+		buffer: make([]*xatu.DecoratedEvent, 0, config.MaxBatchSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// OnRejected sets the handler for batches the sink permanently rejects. Buffered events have already been
+// accepted from their callers, so without a handler they are dropped. Must be called before Start.
+func (b *BatchedSink) OnRejected(fn RejectedHandler) {
+	b.onRejected = fn
+}
+
+func (b *BatchedSink) Start(ctx context.Context) error {
+	if err := b.Sink.Start(ctx); err != nil {
+		return err
+	}
+
+	b.started.Store(true)
+
+	go b.run()
+
+	return nil
+}
+
+// Stop flushes whatever is buffered and stops the sink. It is safe to call more than once, and without Start.
+func (b *BatchedSink) Stop(ctx context.Context) error {
+	b.stopOnce.Do(func() {
+		close(b.stop)
+	})
+
+	if b.started.Load() {
+		select {
+		case <-b.done:
+		case <-ctx.Done():
+			if err := b.Sink.Stop(ctx); err != nil {
+				b.log.WithError(err).Error("Failed to stop sink")
+			}
+
+			return ctx.Err()
+		}
+	}
+
+	if err := b.flush(ctx, false); err != nil {
+		b.log.WithError(err).Error("Failed to flush buffered events on stop")
+	}
+
+	return b.Sink.Stop(ctx)
+}
+
+func (b *BatchedSink) Healthy(ctx context.Context) error {
+	return CheckHealth(ctx, b.Sink)
+}
+
+func (b *BatchedSink) HandleNewDecoratedEvent(ctx context.Context, event *xatu.DecoratedEvent) error {
+	return b.HandleNewDecoratedEvents(ctx, []*xatu.DecoratedEvent{event})
+}
+
+// HandleNewDecoratedEvents buffers the events and flushes every full batch. Once buffered the events belong to
+// the BatchedSink: a failed flush leaves them buffered for the next flush rather than returning an error, which
+// would make the caller hand the same events over again.
+func (b *BatchedSink) HandleNewDecoratedEvents(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	b.mu.Lock()
+
+	// Reject rather than grow the buffer when earlier flushes have left it full. An empty buffer always
+	// accepts so a single oversized call can't be rejected forever.
+	if len(b.buffer) > 0 && len(b.buffer)+len(events) > b.config.MaxBufferSize {
+		buffered := len(b.buffer)
+
+		b.mu.Unlock()
+
+		return fmt.Errorf("%w: %d events buffered", ErrSinkBusy, buffered)
+	}
+
+	b.buffer = append(b.buffer, events...)
+	full := len(b.buffer) >= b.config.MaxBatchSize
+
+	b.mu.Unlock()
+
+	if !full {
+		return nil
+	}
+
+	if err := b.flush(ctx, true); err != nil {
+		b.log.WithError(err).Warn("Failed to flush full batch, keeping events buffered")
+	}
+
+	return nil
+}
+
+func (b *BatchedSink) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), b.config.FlushTimeout)
+
+			err := b.flush(ctx, false)
+
+			cancel()
+
+			if err != nil {
+				b.log.WithError(err).Error("Failed to flush buffered events")
+			}
+		}
+	}
+}
+
+// flush sends what is buffered to the sink in batches of at most MaxBatchSize, or only the full batches when
+// fullOnly is set. Batches that fail stay buffered, along with everything after them. mu is not held while
+// sending, so callers can keep buffering events, or be told the sink is busy, during a slow send.
+func (b *BatchedSink) flush(ctx context.Context, fullOnly bool) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	for {
+		batch := b.nextBatch(fullOnly)
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := b.Sink.HandleNewDecoratedEvents(ctx, batch); err != nil && !b.handlePermanentlyRejected(ctx, err, batch) {
+			return err
+		}
+
+		// Callers only ever append, so the batch is still at the front of the buffer.
+		b.mu.Lock()
+		b.buffer = append(make([]*xatu.DecoratedEvent, 0, b.config.MaxBatchSize), b.buffer[len(batch):]...)
+		b.mu.Unlock()
+	}
+}
+
+// nextBatch copies the next batch from the front of the buffer. It is empty when there is nothing to send.
+func (b *BatchedSink) nextBatch(fullOnly bool) []*xatu.DecoratedEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	size := b.config.MaxBatchSize
+	if len(b.buffer) < size {
+		if fullOnly {
+			return nil
+		}
+
+		size = len(b.buffer)
+	}
+
+	return append([]*xatu.DecoratedEvent{}, b.buffer[:size]...)
+}
+
+// handlePermanentlyRejected reports whether the batch should be removed from the buffer rather than kept for
+// another attempt. Permanently rejected batches are handed to the rejected handler, or dropped without one.
+func (b *BatchedSink) handlePermanentlyRejected(ctx context.Context, err error, batch []*xatu.DecoratedEvent) bool {
+	if !IsPermanent(err) {
+		return false
+	}
+
+	if b.onRejected != nil {
+		b.onRejected(ctx, append([]*xatu.DecoratedEvent{}, batch...), err)
+
+		return true
+	}
+
+	b.log.WithError(err).WithField("events", len(batch)).Error("Sink permanently rejected buffered events, dropping them")
+
+	return true
+}
@@ -0,0 +1,258 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type permanentError struct{ error }
+
+func (permanentError) Permanent() bool { return true }
+
+type recordingSink struct {
+	mu       sync.Mutex
+	received []*xatu.DecoratedEvent
+	calls    int
+	errs     []error
+	stopped  bool
+}
+
+func (s *recordingSink) Start(ctx context.Context) error { return nil }
+
+func (s *recordingSink) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopped = true
+
+	return nil
+}
+
+func (s *recordingSink) Type() string { return "recording" }
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) HandleNewDecoratedEvent(ctx context.Context, event *xatu.DecoratedEvent) error {
+	return s.HandleNewDecoratedEvents(ctx, []*xatu.DecoratedEvent{event})
+}
+
+// HandleNewDecoratedEvents fails with the queued errors, one per call, before accepting events.
+func (s *recordingSink) HandleNewDecoratedEvents(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+
+		return err
+	}
+
+	s.received = append(s.received, events...)
+
+	return nil
+}
+
+func (s *recordingSink) events() []*xatu.DecoratedEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*xatu.DecoratedEvent{}, s.received...)
+}
+
+func newTestEvents(n int) []*xatu.DecoratedEvent {
+	events := make([]*xatu.DecoratedEvent, n)
+
+	for i := range events {
+		events[i] = &xatu.DecoratedEvent{Event: &xatu.Event{Id: fmt.Sprintf("%d", i)}}
+	}
+
+	return events
+}
+
+func newTestBatchedSink(t *testing.T, sink Sink, config BatchConfig) *BatchedSink {
+	t.Helper()
+
+	if config.FlushTimeout == 0 {
+		config.FlushTimeout = time.Second
+	}
+
+	if config.MaxBufferSize == 0 {
+		config.MaxBufferSize = 1000
+	}
+
+	require.NoError(t, config.Validate())
+
+	b := NewBatchedSink(sink, config, logrus.New())
+	require.NoError(t, b.Start(context.Background()))
+
+	return b
+}
+
+func TestBatchedSinkFlushesOnSize(t *testing.T) {
+	sink := &recordingSink{}
+	b := newTestBatchedSink(t, sink, BatchConfig{MaxBatchSize: 2, FlushInterval: time.Hour})
+
+	events := newTestEvents(5)
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events))
+
+	assert.Equal(t, events[:4], sink.events())
+	assert.Equal(t, 2, sink.calls)
+
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events, sink.events())
+}
+
+func TestBatchedSinkFlushesOnInterval(t *testing.T) {
+	sink := &recordingSink{}
+	b := newTestBatchedSink(t, sink, BatchConfig{MaxBatchSize: 10, FlushInterval: 10 * time.Millisecond})
+
+	events := newTestEvents(3)
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events))
+
+	assert.Eventually(t, func() bool {
+		return len(sink.events()) == len(events)
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events, sink.events())
+}
+
+func TestBatchedSinkFlushesOnStop(t *testing.T) {
+	sink := &recordingSink{}
+	b := newTestBatchedSink(t, sink, BatchConfig{MaxBatchSize: 10, FlushInterval: time.Hour})
+
+	events := newTestEvents(3)
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events))
+	assert.Empty(t, sink.events())
+
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events, sink.events())
+	assert.True(t, sink.stopped)
+}
+
+func TestBatchedSinkKeepsEventsBufferedOnFailure(t *testing.T) {
+	sink := &recordingSink{errs: []error{errors.New("unavailable")}}
+	b := newTestBatchedSink(t, sink, BatchConfig{MaxBatchSize: 2, FlushInterval: time.Hour})
+
+	events := newTestEvents(4)
+
+	// The first batch fails, so both calls are accepted and every event stays buffered until the sink recovers.
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events[:2]))
+	assert.Empty(t, sink.events())
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events[2:]))
+
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events, sink.events())
+}
+
+func TestBatchedSinkFlushesBufferedEventsInBatches(t *testing.T) {
+	sink := &recordingSink{errs: []error{errors.New("unavailable"), errors.New("unavailable")}}
+	b := newTestBatchedSink(t, sink, BatchConfig{MaxBatchSize: 2, FlushInterval: time.Hour})
+
+	events := newTestEvents(5)
+
+	// Both full batch flushes fail, leaving more than a batch of events buffered.
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events[:2]))
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events[2:]))
+	assert.Equal(t, 2, sink.calls)
+
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events, sink.events())
+	assert.Equal(t, 5, sink.calls)
+}
+
+func TestBatchedSinkDropsPermanentlyRejectedEvents(t *testing.T) {
+	sink := &recordingSink{errs: []error{permanentError{errors.New("bad request")}}}
+	b := newTestBatchedSink(t, sink, BatchConfig{MaxBatchSize: 2, FlushInterval: time.Hour})
+
+	events := newTestEvents(4)
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events))
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events[2:], sink.events())
+}
+
+func TestBatchedSinkHandsPermanentlyRejectedEventsToHandler(t *testing.T) {
+	rejectErr := permanentError{errors.New("bad request")}
+	sink := &recordingSink{errs: []error{rejectErr}}
+
+	config := BatchConfig{MaxBatchSize: 2, FlushInterval: time.Hour, FlushTimeout: time.Second, MaxBufferSize: 1000}
+	require.NoError(t, config.Validate())
+
+	b := NewBatchedSink(sink, config, logrus.New())
+
+	var (
+		rejected    []*xatu.DecoratedEvent
+		rejectedErr error
+	)
+
+	b.OnRejected(func(ctx context.Context, events []*xatu.DecoratedEvent, err error) {
+		rejected = append(rejected, events...)
+		rejectedErr = err
+	})
+
+	require.NoError(t, b.Start(context.Background()))
+
+	events := newTestEvents(4)
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events))
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events[:2], rejected)
+	assert.Equal(t, rejectErr, rejectedErr)
+	assert.Equal(t, events[2:], sink.events())
+}
+
+func TestBatchedSinkRejectsEventsWhenBufferIsFull(t *testing.T) {
+	sink := &recordingSink{errs: []error{errors.New("unavailable")}}
+	b := newTestBatchedSink(t, sink, BatchConfig{MaxBatchSize: 2, MaxBufferSize: 3, FlushInterval: time.Hour})
+
+	events := newTestEvents(4)
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events[:2]))
+
+	err := b.HandleNewDecoratedEvents(context.Background(), events[2:])
+	require.ErrorIs(t, err, ErrSinkBusy)
+
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events[:2], sink.events())
+}
+
+func TestBatchedSinkStopWithoutStart(t *testing.T) {
+	sink := &recordingSink{}
+	b := NewBatchedSink(sink, BatchConfig{MaxBatchSize: 2, FlushInterval: time.Hour, MaxBufferSize: 10}, logrus.New())
+
+	events := newTestEvents(1)
+
+	require.NoError(t, b.HandleNewDecoratedEvents(context.Background(), events))
+
+	// Without Start there is no flush loop to wait for, and stopping twice must not panic.
+	require.NoError(t, b.Stop(context.Background()))
+	require.NoError(t, b.Stop(context.Background()))
+
+	assert.Equal(t, events, sink.events())
+	assert.True(t, sink.stopped)
+}
@@ -0,0 +1,170 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type ItemExporter struct {
+	name   string
+	config *Config
+	log    logrus.FieldLogger
+
+	conn driver.Conn
+}
+
+func NewItemExporter(name string, config *Config, log logrus.FieldLogger) (ItemExporter, error) {
+	opts, err := clickhouse.ParseDSN(config.DSN)
+	if err != nil {
+		return ItemExporter{}, err
+	}
+
+	opts.MaxOpenConns = config.MaxOpenConns
+	opts.MaxIdleConns = config.MaxIdleConns
+	opts.ConnMaxLifetime = config.ConnMaxLifetime
+	opts.DialTimeout = config.DialTimeout
+
+	if config.AsyncInsert {
+		if opts.Settings == nil {
+			opts.Settings = clickhouse.Settings{}
+		}
+
+		opts.Settings["async_insert"] = 1
+		opts.Settings["wait_for_async_insert"] = 0
+
+		if config.WaitForAsyncInsert {
+			opts.Settings["wait_for_async_insert"] = 1
+		}
+	}
+
+	conn, err := clickhouse.Open(opts)
+	if err != nil {
+		return ItemExporter{}, err
+	}
+
+	return ItemExporter{
+		name:   name,
+		config: config,
+		log:    log.WithField("output_name", name).WithField("output_type", SinkType),
+		conn:   conn,
+	}, nil
+}
+
+func (e ItemExporter) ExportItems(ctx context.Context, items []*xatu.DecoratedEvent) error {
+	_, span := observability.Tracer().Start(ctx, "ClickHouseItemExporter.ExportItems", trace.WithAttributes(attribute.Int64("num_events", int64(len(items)))))
+	defer span.End()
+
+	e.log.WithField("events", len(items)).Debug("Sending batch of events to ClickHouse sink")
+
+	if err := e.insert(ctx, items); err != nil {
+		e.log.
+			WithError(err).
+			WithField("num_events", len(items)).
+			Error("Failed to insert events into ClickHouse")
+
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Healthy pings the ClickHouse server.
+func (e ItemExporter) Healthy(ctx context.Context) error {
+	return e.conn.Ping(ctx)
+}
+
+func (e ItemExporter) Shutdown(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+func (e *ItemExporter) insert(ctx context.Context, items []*xatu.DecoratedEvent) error {
+	byTable := make(map[string][]*xatu.DecoratedEvent)
+	tables := []string{}
+
+	for _, event := range items {
+		table := e.tableName(event)
+
+		if _, ok := byTable[table]; !ok {
+			tables = append(tables, table)
+		}
+
+		byTable[table] = append(byTable[table], event)
+	}
+
+	for _, table := range tables {
+		events := byTable[table]
+		batchSize := e.batchSize(events[0])
+
+		for start := 0; start < len(events); start += batchSize {
+			end := start + batchSize
+			if end > len(events) {
+				end = len(events)
+			}
+
+			if err := e.insertBatch(ctx, table, events[start:end]); err != nil {
+				return fmt.Errorf("failed to insert into %s: %w", table, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *ItemExporter) insertBatch(ctx context.Context, table string, events []*xatu.DecoratedEvent) error {
+	batch, err := e.conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s", table))
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		data, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Append(
+			event.GetEvent().GetName().String(),
+			event.GetEvent().GetDateTime().AsTime(),
+			event.GetEvent().GetId(),
+			event.GetMeta().GetClient().GetName(),
+			event.GetMeta().GetClient().GetId(),
+			event.GetMeta().GetClient().GetEthereum().GetNetwork().GetName(),
+			string(data),
+		); err != nil {
+			return err
+		}
+	}
+
+	return batch.Send()
+}
+
+func (e *ItemExporter) tableName(event *xatu.DecoratedEvent) string {
+	eventName := event.GetEvent().GetName().String()
+
+	if table, ok := e.config.Tables[eventName]; ok && table.Name != "" {
+		return table.Name
+	}
+
+	return strings.ToLower(eventName)
+}
+
+func (e *ItemExporter) batchSize(event *xatu.DecoratedEvent) int {
+	if table, ok := e.config.Tables[event.GetEvent().GetName().String()]; ok && table.BatchSize > 0 {
+		return table.BatchSize
+	}
+
+	return e.config.TableBatchSize
+}
@@ -5,9 +5,14 @@ import (
 	"fmt"
 
 	"github.com/creasty/defaults"
+	"github.com/ethpandaops/xatu/pkg/output/clickhouse"
+	"github.com/ethpandaops/xatu/pkg/output/file"
 	"github.com/ethpandaops/xatu/pkg/output/http"
 	"github.com/ethpandaops/xatu/pkg/output/kafka"
+	"github.com/ethpandaops/xatu/pkg/output/nats"
+	"github.com/ethpandaops/xatu/pkg/output/s3"
 	"github.com/ethpandaops/xatu/pkg/output/stdout"
+	"github.com/ethpandaops/xatu/pkg/output/websocket"
 	"github.com/ethpandaops/xatu/pkg/output/xatu"
 	"github.com/ethpandaops/xatu/pkg/processor"
 	pxatu "github.com/ethpandaops/xatu/pkg/proto/xatu"
@@ -21,6 +26,11 @@ type Config struct {
 	Config *RawMessage `yaml:"config"`
 
 	FilterConfig pxatu.EventFilterConfig `yaml:"filter"`
+
+	Batch BatchConfig `yaml:"batch"`
+
+	// Optional excludes the sink from readiness checks, so its upstream being down doesn't mark the client unready.
+	Optional bool `yaml:"optional"`
 }
 
 func (c *Config) Validate() error {
@@ -28,10 +38,16 @@ func (c *Config) Validate() error {
 		return errors.New("sink type is required")
 	}
 
+	if err := c.Batch.Validate(); err != nil {
+		return fmt.Errorf("invalid batch config: %w", err)
+	}
+
 	return nil
 }
 
-func NewSink(name string, sinkType SinkType, config *RawMessage, log logrus.FieldLogger, filterConfig pxatu.EventFilterConfig, shippingMethod processor.ShippingMethod) (Sink, error) {
+// NewSink creates a sink of the given type. userAgent is sent by sinks that make HTTP requests unless their
+// config sets one, and may be empty to use the Go default.
+func NewSink(name string, sinkType SinkType, config *RawMessage, log logrus.FieldLogger, filterConfig pxatu.EventFilterConfig, shippingMethod processor.ShippingMethod, userAgent string) (Sink, error) {
 	if sinkType == SinkTypeUnknown {
 		return nil, errors.New("sink type is required")
 	}
@@ -50,6 +66,10 @@ func NewSink(name string, sinkType SinkType, config *RawMessage, log logrus.Fiel
 			return nil, err
 		}
 
+		if conf.UserAgent == "" {
+			conf.UserAgent = userAgent
+		}
+
 		return http.New(name, conf, log, &filterConfig, shippingMethod)
 	case SinkTypeStdOut:
 		conf := &stdout.Config{}
@@ -65,6 +85,62 @@ func NewSink(name string, sinkType SinkType, config *RawMessage, log logrus.Fiel
 		}
 
 		return stdout.New(name, conf, log, &filterConfig, shippingMethod)
+	case SinkTypeFile:
+		conf := &file.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		return file.New(name, conf, log, &filterConfig, shippingMethod)
+	case SinkTypeNATS:
+		conf := &nats.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		return nats.New(name, conf, log, &filterConfig, shippingMethod)
+	case SinkTypeS3:
+		conf := &s3.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		return s3.New(name, conf, log, &filterConfig, shippingMethod)
+	case SinkTypeWebSocket:
+		conf := &websocket.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		return websocket.New(name, conf, log, &filterConfig, shippingMethod)
 	case SinkTypeXatu:
 		conf := &xatu.Config{}
 
@@ -93,6 +169,20 @@ func NewSink(name string, sinkType SinkType, config *RawMessage, log logrus.Fiel
 		}
 
 		return kafka.New(name, conf, log, &filterConfig, shippingMethod)
+	case SinkTypeClickHouse:
+		conf := &clickhouse.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		return clickhouse.New(name, conf, log, &filterConfig, shippingMethod)
 	default:
 		return nil, fmt.Errorf("sink type %s is unknown", sinkType)
 	}
@@ -0,0 +1,24 @@
+package file
+
+import (
+	"errors"
+)
+
+type Config struct {
+	// Path is the file that events are appended to as newline-delimited JSON.
+	Path string `yaml:"path"`
+	// MaxSizeBytes rotates the file once it grows beyond this size. 0 disables rotation.
+	MaxSizeBytes int64 `yaml:"maxSizeBytes" default:"0"`
+}
+
+func (c *Config) Validate() error {
+	if c.Path == "" {
+		return errors.New("path is required")
+	}
+
+	if c.MaxSizeBytes < 0 {
+		return errors.New("maxSizeBytes must be greater than or equal to 0")
+	}
+
+	return nil
+}
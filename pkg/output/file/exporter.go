@@ -0,0 +1,152 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/observability"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type ItemExporter struct {
+	config *Config
+	log    logrus.FieldLogger
+
+	mu     sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	size   int64
+}
+
+func NewItemExporter(name string, config *Config, log logrus.FieldLogger) (*ItemExporter, error) {
+	e := &ItemExporter{
+		config: config,
+		log:    log.WithField("output_name", name).WithField("output_type", SinkType),
+	}
+
+	if err := e.open(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (e *ItemExporter) ExportItems(ctx context.Context, items []*xatu.DecoratedEvent) error {
+	_, span := observability.Tracer().Start(ctx, "FileItemExporter.ExportItems", trace.WithAttributes(attribute.Int64("num_events", int64(len(items)))))
+	defer span.End()
+
+	e.log.WithField("events", len(items)).Debug("Sending batch of events to file sink")
+
+	if err := e.sendUpstream(items); err != nil {
+		e.log.
+			WithError(err).
+			WithField("num_events", len(items)).
+			Error("Failed to write events to file")
+
+		return err
+	}
+
+	return nil
+}
+
+func (e *ItemExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.close()
+}
+
+func (e *ItemExporter) sendUpstream(items []*xatu.DecoratedEvent) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.file == nil {
+		return fmt.Errorf("file %s is closed", e.config.Path)
+	}
+
+	for _, event := range items {
+		line, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		line = append(line, '\n')
+
+		if e.config.MaxSizeBytes > 0 && e.size > 0 && e.size+int64(len(line)) > e.config.MaxSizeBytes {
+			if err := e.rotate(); err != nil {
+				return err
+			}
+		}
+
+		n, err := e.writer.Write(line)
+		e.size += int64(n)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.writer.Flush()
+}
+
+func (e *ItemExporter) open() error {
+	f, err := os.OpenFile(e.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	e.file = f
+	e.writer = bufio.NewWriter(f)
+	e.size = info.Size()
+
+	return nil
+}
+
+func (e *ItemExporter) close() error {
+	if e.file == nil {
+		return nil
+	}
+
+	flushErr := e.writer.Flush()
+	closeErr := e.file.Close()
+
+	e.file = nil
+	e.writer = nil
+
+	if flushErr != nil {
+		return flushErr
+	}
+
+	return closeErr
+}
+
+func (e *ItemExporter) rotate() error {
+	if err := e.close(); err != nil {
+		return err
+	}
+
+	rotated := fmt.Sprintf("%s.%s", e.config.Path, time.Now().UTC().Format("20060102T150405.000000000"))
+
+	if err := os.Rename(e.config.Path, rotated); err != nil {
+		return err
+	}
+
+	e.log.WithField("rotated_to", rotated).Info("Rotated output file")
+
+	return e.open()
+}
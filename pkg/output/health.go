@@ -0,0 +1,21 @@
+package output
+
+import (
+	"context"
+)
+
+// HealthChecker is implemented by sinks that can tell whether their upstream is able to accept events, e.g.
+// whether a broker is reachable. Sinks that don't implement it are assumed healthy.
+type HealthChecker interface {
+	Healthy(ctx context.Context) error
+}
+
+// CheckHealth returns an error if the sink reports its upstream can't accept events.
+func CheckHealth(ctx context.Context, sink Sink) error {
+	checker, ok := sink.(HealthChecker)
+	if !ok {
+		return nil
+	}
+
+	return checker.Healthy(ctx)
+}
@@ -2,19 +2,32 @@ package http
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
 	"time"
+
+	"github.com/ethpandaops/xatu/pkg/output/auth"
 )
 
 type Config struct {
 	Address            string              `yaml:"address"`
 	Headers            map[string]string   `yaml:"headers"`
+	UserAgent          string              `yaml:"userAgent"`
+	Auth               auth.Config         `yaml:"auth"`
 	MaxQueueSize       int                 `yaml:"maxQueueSize" default:"51200"`
 	BatchTimeout       time.Duration       `yaml:"batchTimeout" default:"5s"`
 	ExportTimeout      time.Duration       `yaml:"exportTimeout" default:"30s"`
 	MaxExportBatchSize int                 `yaml:"maxExportBatchSize" default:"512"`
 	Compression        CompressionStrategy `yaml:"compression" default:"none"`
+	Encoding           Encoding            `yaml:"encoding" default:"json"`
 	KeepAlive          *bool               `yaml:"keepAlive" default:"true"`
 	Workers            int                 `yaml:"workers" default:"1"`
+	// HealthCheckAddress is requested with a GET to check the upstream is up, which must respond with a 2xx.
+	// The upstream isn't checked when empty.
+	HealthCheckAddress string `yaml:"healthCheckAddress"`
+	// RetryableStatusCodes are the response status codes worth retrying the same batch for. Any other non-200
+	// response fails the batch permanently. Defaults to 408, 429 and every 5xx when empty.
+	RetryableStatusCodes []int `yaml:"retryableStatusCodes"`
 }
 
 func (c *Config) Validate() error {
@@ -22,5 +35,42 @@ func (c *Config) Validate() error {
 		return errors.New("address is required")
 	}
 
+	if err := c.Auth.Validate(); err != nil {
+		return fmt.Errorf("invalid auth config: %w", err)
+	}
+
+	switch c.Compression {
+	case "", CompressionStrategyNone, CompressionStrategyGzip:
+	default:
+		return fmt.Errorf("unsupported compression: %s", c.Compression)
+	}
+
+	switch c.Encoding {
+	case "", EncodingJSON, EncodingProtobuf:
+	default:
+		return fmt.Errorf("unsupported encoding: %s", c.Encoding)
+	}
+
+	for _, code := range c.RetryableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid retryable status code: %d", code)
+		}
+	}
+
 	return nil
 }
+
+// IsRetryableStatusCode reports whether a response with the status code should be retried.
+func (c *Config) IsRetryableStatusCode(code int) bool {
+	if len(c.RetryableStatusCodes) == 0 {
+		return code >= 500 || code == http.StatusTooManyRequests || code == http.StatusRequestTimeout
+	}
+
+	for _, retryable := range c.RetryableStatusCodes {
+		if code == retryable {
+			return true
+		}
+	}
+
+	return false
+}
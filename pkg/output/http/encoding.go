@@ -0,0 +1,51 @@
+package http
+
+import (
+	"bytes"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type Encoding string
+
+var (
+	// EncodingJSON sends one protojson encoded event per line.
+	EncodingJSON Encoding = "json"
+	// EncodingProtobuf sends the batch as a single binary encoded xatu.CreateEventsRequest.
+	EncodingProtobuf Encoding = "protobuf"
+)
+
+const (
+	ContentTypeNDJSON   = "application/x-ndjson"
+	ContentTypeProtobuf = "application/protobuf"
+)
+
+func (e Encoding) ContentType() string {
+	if e == EncodingProtobuf {
+		return ContentTypeProtobuf
+	}
+
+	return ContentTypeNDJSON
+}
+
+func encodeEvents(encoding Encoding, events []*xatu.DecoratedEvent) ([]byte, error) {
+	if encoding == EncodingProtobuf {
+		return proto.Marshal(&xatu.CreateEventsRequest{Events: events})
+	}
+
+	var buf bytes.Buffer
+
+	for _, event := range events {
+		eventAsJSON, err := protojson.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(eventAsJSON)
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
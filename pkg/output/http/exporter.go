@@ -14,10 +14,25 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// StatusError is returned when the server responds with a status code other than 200.
+type StatusError struct {
+	StatusCode int
+	Retryable  bool
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status code: %d", e.StatusCode)
+}
+
+// Permanent reports whether sending the same batch again will fail the same way.
+func (e *StatusError) Permanent() bool {
+	return !e.Retryable
+}
+
 type ItemExporter struct {
+	name   string
 	config *Config
 	log    logrus.FieldLogger
 
@@ -31,6 +46,7 @@ func NewItemExporter(name string, config *Config, log logrus.FieldLogger) (ItemE
 	}
 
 	return ItemExporter{
+		name:   name,
 		config: config,
 		log:    log.WithField("output_name", name).WithField("output_type", SinkType),
 
@@ -61,6 +77,51 @@ func (e ItemExporter) ExportItems(ctx context.Context, items []*xatu.DecoratedEv
 	return nil
 }
 
+// Healthy requests the health check address, if one is configured, and expects a 2xx response.
+func (e ItemExporter) Healthy(ctx context.Context) error {
+	if e.config.HealthCheckAddress == "" {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.config.HealthCheckAddress, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	if e.config.UserAgent != "" {
+		req.Header.Set("User-Agent", e.config.UserAgent)
+	}
+
+	for k, v := range e.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	authorization, err := e.config.Auth.AuthorizationHeader()
+	if err != nil {
+		return err
+	}
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	rsp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer rsp.Body.Close()
+
+	//nolint:errcheck // The body is only drained so the connection can be reused.
+	io.Copy(io.Discard, rsp.Body)
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return fmt.Errorf("health check returned status code %d", rsp.StatusCode)
+	}
+
+	return nil
+}
+
 func (e ItemExporter) Shutdown(ctx context.Context) error {
 	return nil
 }
@@ -70,24 +131,22 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 
 	var rsp *http.Response
 
-	body := ""
-
-	for _, event := range items {
-		eventAsJSON, err := protojson.Marshal(event)
-		if err != nil {
-			return err
-		}
-
-		body += string(eventAsJSON) + "\n"
+	body, err := encodeEvents(e.config.Encoding, items)
+	if err != nil {
+		return err
 	}
 
-	buf := bytes.NewBufferString(body)
+	buf := bytes.NewBuffer(body)
 	if e.config.Compression == CompressionStrategyGzip {
 		compressed, err := e.gzip(buf)
 		if err != nil {
 			return err
 		}
 
+		if saved := len(body) - compressed.Len(); saved > 0 {
+			DefaultMetrics.AddCompressionBytesSaved(e.name, e.config.Compression, float64(saved))
+		}
+
 		buf = compressed
 	}
 
@@ -97,11 +156,24 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 		return err
 	}
 
+	if e.config.UserAgent != "" {
+		req.Header.Set("User-Agent", e.config.UserAgent)
+	}
+
 	for k, v := range e.config.Headers {
 		req.Header.Set(k, v)
 	}
 
-	req.Header.Set("Content-Type", "application/x-ndjson")
+	authorization, err := e.config.Auth.AuthorizationHeader()
+	if err != nil {
+		return err
+	}
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	req.Header.Set("Content-Type", e.config.Encoding.ContentType())
 
 	if e.config.Compression == CompressionStrategyGzip {
 		req.Header.Set("Content-Encoding", "gzip")
@@ -115,7 +187,10 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 	defer rsp.Body.Close()
 
 	if rsp.StatusCode != http.StatusOK {
-		return fmt.Errorf("status code: %d", rsp.StatusCode)
+		return &StatusError{
+			StatusCode: rsp.StatusCode,
+			Retryable:  e.config.IsRetryableStatusCode(rsp.StatusCode),
+		}
 	}
 
 	_, err = io.ReadAll(rsp.Body)
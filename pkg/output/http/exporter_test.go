@@ -0,0 +1,226 @@
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type receiver struct {
+	mu           sync.Mutex
+	encodings    []string
+	contentTypes []string
+	events       []*xatu.DecoratedEvent
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	events, err := decodeEvents(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.encodings = append(r.encodings, req.Header.Get("Content-Encoding"))
+	r.contentTypes = append(r.contentTypes, req.Header.Get("Content-Type"))
+	r.events = append(r.events, events...)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// decodeEvents reads the events back out of a request sent by the exporter.
+func decodeEvents(req *http.Request) ([]*xatu.DecoratedEvent, error) {
+	body := io.Reader(req.Body)
+
+	if req.Header.Get("Content-Encoding") == string(CompressionStrategyGzip) {
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+
+		body = gz
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Header.Get("Content-Type") == ContentTypeProtobuf {
+		batch := &xatu.CreateEventsRequest{}
+		if err := proto.Unmarshal(data, batch); err != nil {
+			return nil, err
+		}
+
+		return batch.GetEvents(), nil
+	}
+
+	events := []*xatu.DecoratedEvent{}
+
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		event := &xatu.DecoratedEvent{}
+		if err := protojson.Unmarshal(line, event); err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func testEvents(count int) []*xatu.DecoratedEvent {
+	events := make([]*xatu.DecoratedEvent, 0, count)
+
+	for i := 0; i < count; i++ {
+		events = append(events, &xatu.DecoratedEvent{
+			Event: &xatu.Event{
+				Name:     xatu.Event_BEACON_API_ETH_V1_EVENTS_HEAD,
+				DateTime: timestamppb.New(time.Unix(int64(1700000000+i), 0)),
+				Id:       fmt.Sprintf("event-%d", i),
+			},
+			Meta: &xatu.Meta{
+				Client: &xatu.ClientMeta{
+					Name:    "test-client",
+					Version: "v0.0.0",
+				},
+			},
+		})
+	}
+
+	return events
+}
+
+func TestExporterCompressionRoundTrip(t *testing.T) {
+	events := testEvents(100)
+
+	received := map[CompressionStrategy][]*xatu.DecoratedEvent{}
+
+	for _, compression := range []CompressionStrategy{CompressionStrategyNone, CompressionStrategyGzip} {
+		r := &receiver{}
+		server := httptest.NewServer(r)
+
+		exporter, err := NewItemExporter("test", &Config{
+			Address:       server.URL,
+			Compression:   compression,
+			ExportTimeout: 5 * time.Second,
+		}, logrus.New())
+		require.NoError(t, err)
+
+		require.NoError(t, exporter.ExportItems(context.Background(), events))
+
+		server.Close()
+
+		require.Len(t, r.encodings, 1)
+
+		if compression == CompressionStrategyGzip {
+			assert.Equal(t, "gzip", r.encodings[0])
+		} else {
+			assert.Empty(t, r.encodings[0])
+		}
+
+		received[compression] = r.events
+	}
+
+	require.Len(t, received[CompressionStrategyNone], len(events))
+	require.Len(t, received[CompressionStrategyGzip], len(events))
+
+	for i := range events {
+		assert.True(t, proto.Equal(events[i], received[CompressionStrategyNone][i]))
+		assert.True(t, proto.Equal(received[CompressionStrategyNone][i], received[CompressionStrategyGzip][i]))
+	}
+}
+
+func TestExporterEncodingRoundTrip(t *testing.T) {
+	events := testEvents(100)
+
+	for _, encoding := range []Encoding{EncodingJSON, EncodingProtobuf} {
+		for _, compression := range []CompressionStrategy{CompressionStrategyNone, CompressionStrategyGzip} {
+			r := &receiver{}
+			server := httptest.NewServer(r)
+
+			exporter, err := NewItemExporter("test", &Config{
+				Address:       server.URL,
+				Compression:   compression,
+				Encoding:      encoding,
+				ExportTimeout: 5 * time.Second,
+			}, logrus.New())
+			require.NoError(t, err)
+
+			require.NoError(t, exporter.ExportItems(context.Background(), events))
+
+			server.Close()
+
+			require.Len(t, r.contentTypes, 1)
+			assert.Equal(t, encoding.ContentType(), r.contentTypes[0])
+
+			require.Len(t, r.events, len(events))
+
+			for i := range events {
+				assert.True(t, proto.Equal(events[i], r.events[i]), "encoding %s compression %s event %d", encoding, compression, i)
+			}
+		}
+	}
+}
+
+func TestExporterStatusErrorRetryable(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		retryable  []int
+		permanent  bool
+	}{
+		{name: "bad request", statusCode: http.StatusBadRequest, permanent: true},
+		{name: "too many requests", statusCode: http.StatusTooManyRequests, permanent: false},
+		{name: "server error", statusCode: http.StatusBadGateway, permanent: false},
+		{name: "configured bad request", statusCode: http.StatusBadRequest, retryable: []int{http.StatusBadRequest}, permanent: false},
+		{name: "configured excludes server error", statusCode: http.StatusInternalServerError, retryable: []int{http.StatusServiceUnavailable}, permanent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			exporter, err := NewItemExporter("test", &Config{
+				Address:              server.URL,
+				ExportTimeout:        5 * time.Second,
+				RetryableStatusCodes: tt.retryable,
+			}, logrus.New())
+			require.NoError(t, err)
+
+			err = exporter.ExportItems(context.Background(), testEvents(1))
+			require.Error(t, err)
+
+			var statusErr *StatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, tt.statusCode, statusErr.StatusCode)
+			assert.Equal(t, tt.permanent, statusErr.Permanent())
+		})
+	}
+}
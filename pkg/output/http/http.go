@@ -12,11 +12,12 @@ import (
 const SinkType = "http"
 
 type HTTP struct {
-	name   string
-	config *Config
-	log    logrus.FieldLogger
-	proc   *processor.BatchItemProcessor[xatu.DecoratedEvent]
-	filter xatu.EventFilter
+	name     string
+	config   *Config
+	log      logrus.FieldLogger
+	proc     *processor.BatchItemProcessor[xatu.DecoratedEvent]
+	exporter ItemExporter
+	filter   xatu.EventFilter
 }
 
 func New(name string, config *Config, log logrus.FieldLogger, filterConfig *xatu.EventFilterConfig, shippingMethod processor.ShippingMethod) (*HTTP, error) {
@@ -53,11 +54,12 @@ func New(name string, config *Config, log logrus.FieldLogger, filterConfig *xatu
 	}
 
 	return &HTTP{
-		name:   name,
-		config: config,
-		log:    log,
-		proc:   proc,
-		filter: filter,
+		name:     name,
+		config:   config,
+		log:      log,
+		proc:     proc,
+		exporter: exporter,
+		filter:   filter,
 	}, nil
 }
 
@@ -65,6 +67,11 @@ func (h *HTTP) Type() string {
 	return SinkType
 }
 
+// Healthy reports whether the upstream can accept events.
+func (h *HTTP) Healthy(ctx context.Context) error {
+	return h.exporter.Healthy(ctx)
+}
+
 func (h *HTTP) Start(ctx context.Context) error {
 	return nil
 }
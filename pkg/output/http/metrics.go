@@ -0,0 +1,35 @@
+package http
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	DefaultMetrics = NewMetrics("xatu")
+)
+
+type Metrics struct {
+	compressionBytesSaved *prometheus.CounterVec
+}
+
+func NewMetrics(namespace string) *Metrics {
+	if namespace != "" {
+		namespace += "_"
+	}
+
+	namespace += "output_http"
+
+	m := &Metrics{
+		compressionBytesSaved: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:      "compression_bytes_saved_total",
+			Namespace: namespace,
+			Help:      "Number of request body bytes saved by compression",
+		}, []string{"output_name", "compression"}),
+	}
+
+	prometheus.MustRegister(m.compressionBytesSaved)
+
+	return m
+}
+
+func (m *Metrics) AddCompressionBytesSaved(name string, compression CompressionStrategy, count float64) {
+	m.compressionBytesSaved.WithLabelValues(name, string(compression)).Add(count)
+}
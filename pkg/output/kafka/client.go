@@ -1,6 +1,10 @@
 package kafka
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
 	"strings"
 
 	"github.com/IBM/sarama"
@@ -31,13 +35,45 @@ var (
 	PartitionStrategyRandom PartitionStrategy = "random"
 )
 
+type PartitionKey string
+
+var (
+	PartitionKeyEventID   PartitionKey = "event_id"
+	PartitionKeySlot      PartitionKey = "slot"
+	PartitionKeyBlockRoot PartitionKey = "block_root"
+	PartitionKeyEventType PartitionKey = "event_type"
+)
+
+type SASLMechanism string
+
+var (
+	SASLMechanismPlain SASLMechanism = sarama.SASLTypePlaintext
+)
+
 func NewSyncProducer(config *Config) (sarama.SyncProducer, error) {
-	producerConfig := Init(config)
+	producerConfig, err := Init(config)
+	if err != nil {
+		return nil, err
+	}
+
 	brokersList := strings.Split(config.Brokers, ",")
 
 	return sarama.NewSyncProducer(brokersList, producerConfig)
 }
-func Init(config *Config) *sarama.Config {
+
+// NewClient returns a client for the brokers that a producer can be created from with
+// sarama.NewSyncProducerFromClient, while still being usable to check on the brokers.
+func NewClient(config *Config) (sarama.Client, error) {
+	clientConfig, err := Init(config)
+	if err != nil {
+		return nil, err
+	}
+
+	brokersList := strings.Split(config.Brokers, ",")
+
+	return sarama.NewClient(brokersList, clientConfig)
+}
+func Init(config *Config) (*sarama.Config, error) {
 	c := sarama.NewConfig()
 	c.Producer.Flush.Bytes = config.FlushBytes
 	c.Producer.Flush.Messages = config.FlushMessages
@@ -74,5 +110,55 @@ func Init(config *Config) *sarama.Config {
 		c.Producer.Partitioner = sarama.NewRandomPartitioner
 	}
 
-	return c
+	if config.TLS {
+		tlsConfig, err := newTLSConfig(&config.TLSClientConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		c.Net.TLS.Enable = true
+		c.Net.TLS.Config = tlsConfig
+	}
+
+	if config.SASLConfig != nil {
+		c.Net.SASL.Enable = true
+		c.Net.SASL.Mechanism = sarama.SASLMechanism(config.SASLConfig.Mechanism)
+		c.Net.SASL.User = config.SASLConfig.User
+		c.Net.SASL.Password = config.SASLConfig.Password
+	}
+
+	return c, nil
+}
+
+func newTLSConfig(config *TLSClientConfig) (*tls.Config, error) {
+	//nolint:gosec // InsecureSkipVerify is opt-in.
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertificatePath != "" {
+		ca, err := os.ReadFile(config.CACertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse ca certificate %s", config.CACertificatePath)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.CertificatePath != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertificatePath, config.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
 }
@@ -2,21 +2,39 @@ package kafka
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
 type Config struct {
-	Brokers        string              `yaml:"brokers"`
-	Topic          string              `yaml:"topic"`
-	TLS            bool                `yaml:"tls" default:"false"`
-	MaxQueueSize   int                 `yaml:"maxQueueSize" default:"51200"`
-	FlushFrequency time.Duration       `yaml:"flushFrequency" default:"10s"`
-	FlushMessages  int                 `yaml:"flushMessages" default:"500"`
-	FlushBytes     int                 `yaml:"flushBytes" default:"1000000"`
-	MaxRetries     int                 `yaml:"maxRetries" default:"3"`
-	Compression    CompressionStrategy `yaml:"compression" default:"none"`
-	RequiredAcks   RequiredAcks        `yaml:"requiredAcks" default:"leader"`
-	Partitioning   PartitionStrategy   `yaml:"partitioning" default:"none"`
+	Brokers         string              `yaml:"brokers"`
+	Topic           string              `yaml:"topic"`
+	TLS             bool                `yaml:"tls" default:"false"`
+	TLSClientConfig TLSClientConfig     `yaml:"tlsClientConfig"`
+	SASLConfig      *SASLConfig         `yaml:"sasl"`
+	MaxQueueSize    int                 `yaml:"maxQueueSize" default:"51200"`
+	FlushFrequency  time.Duration       `yaml:"flushFrequency" default:"10s"`
+	FlushMessages   int                 `yaml:"flushMessages" default:"500"`
+	FlushBytes      int                 `yaml:"flushBytes" default:"1000000"`
+	MaxRetries      int                 `yaml:"maxRetries" default:"3"`
+	Compression     CompressionStrategy `yaml:"compression" default:"none"`
+	RequiredAcks    RequiredAcks        `yaml:"requiredAcks" default:"leader"`
+	Partitioning    PartitionStrategy   `yaml:"partitioning" default:"none"`
+	// PartitionKey is the event field used as the message key, which decides the partition when partitioning is none.
+	PartitionKey PartitionKey `yaml:"partitionKey" default:"event_id"`
+}
+
+type TLSClientConfig struct {
+	CACertificatePath  string `yaml:"caCertificatePath"`
+	CertificatePath    string `yaml:"certificatePath"`
+	KeyPath            string `yaml:"keyPath"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify" default:"false"`
+}
+
+type SASLConfig struct {
+	Mechanism SASLMechanism `yaml:"mechanism" default:"PLAIN"`
+	User      string        `yaml:"user"`
+	Password  string        `yaml:"password"`
 }
 
 func (c *Config) Validate() error {
@@ -28,5 +46,33 @@ func (c *Config) Validate() error {
 		return errors.New("topic is required")
 	}
 
+	switch c.PartitionKey {
+	case PartitionKeyEventID, PartitionKeySlot, PartitionKeyBlockRoot, PartitionKeyEventType:
+	default:
+		return fmt.Errorf("unsupported partitionKey: %s", c.PartitionKey)
+	}
+
+	if (c.TLSClientConfig.CertificatePath == "") != (c.TLSClientConfig.KeyPath == "") {
+		return errors.New("tlsClientConfig.certificatePath and tlsClientConfig.keyPath must be set together")
+	}
+
+	if c.SASLConfig != nil {
+		if err := c.SASLConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid sasl config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *SASLConfig) Validate() error {
+	if c.Mechanism != SASLMechanismPlain {
+		return fmt.Errorf("unsupported mechanism: %s", c.Mechanism)
+	}
+
+	if c.User == "" {
+		return errors.New("user is required")
+	}
+
 	return nil
 }
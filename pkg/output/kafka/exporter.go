@@ -3,6 +3,7 @@ package kafka
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/IBM/sarama"
 	"github.com/ethpandaops/xatu/pkg/observability"
@@ -15,15 +16,31 @@ import (
 )
 
 type ItemExporter struct {
+	name   string
 	config *Config
 	log    logrus.FieldLogger
 	client sarama.SyncProducer
+	// brokers is the client the producer was created from, kept to check on the brokers
+	brokers sarama.Client
 }
 
 func NewItemExporter(name string, config *Config, log logrus.FieldLogger) (ItemExporter, error) {
-	producer, err := NewSyncProducer(config)
+	brokers, err := NewClient(config)
+	if err != nil {
+		log.
+			WithError(err).
+			WithField("output_name", name).
+			WithField("output_type", SinkType).
+			Error("Error while creating the Kafka Client")
+
+		return ItemExporter{}, err
+	}
+
+	producer, err := sarama.NewSyncProducerFromClient(brokers)
 
 	if err != nil {
+		_ = brokers.Close()
+
 		log.
 			WithError(err).
 			WithField("output_name", name).
@@ -34,9 +51,11 @@ func NewItemExporter(name string, config *Config, log logrus.FieldLogger) (ItemE
 	}
 
 	return ItemExporter{
-		config: config,
-		log:    log.WithField("output_name", name).WithField("output_type", SinkType),
-		client: producer,
+		name:    name,
+		config:  config,
+		log:     log.WithField("output_name", name).WithField("output_type", SinkType),
+		client:  producer,
+		brokers: brokers,
 	}, nil
 }
 func (e ItemExporter) ExportItems(ctx context.Context, items []*xatu.DecoratedEvent) error {
@@ -59,8 +78,19 @@ func (e ItemExporter) ExportItems(ctx context.Context, items []*xatu.DecoratedEv
 	return nil
 }
 
+// Shutdown closes the producer. The processor has already flushed any queued events by the time this is called.
 func (e ItemExporter) Shutdown(ctx context.Context) error {
-	return nil
+	if err := e.client.Close(); err != nil {
+		return err
+	}
+
+	// Producers created from a client leave closing it to the caller.
+	return e.brokers.Close()
+}
+
+// Healthy refreshes the topic metadata, which fails if none of the brokers can be reached.
+func (e ItemExporter) Healthy(ctx context.Context) error {
+	return e.brokers.RefreshMetadata(e.config.Topic)
 }
 
 func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.DecoratedEvent) error {
@@ -73,7 +103,7 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 			return err
 		}
 
-		routingKey, eventPayload := sarama.StringEncoder(p.Event.Id), sarama.StringEncoder(r)
+		routingKey, eventPayload := sarama.StringEncoder(e.partitionKey(p)), sarama.StringEncoder(r)
 		m := &sarama.ProducerMessage{
 			Topic: e.config.Topic,
 			Key:   routingKey,
@@ -82,7 +112,7 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 
 		msgByteSize = m.ByteSize(2)
 		if msgByteSize > e.config.FlushBytes {
-			e.log.WithField("event_id", routingKey).WithField("msg_size", msgByteSize).Debug("Message too large, consider increasing `max_message_bytes`")
+			e.log.WithField("event_id", p.GetEvent().GetId()).WithField("msg_size", msgByteSize).Debug("Message too large, consider increasing `max_message_bytes`")
 
 			continue
 		}
@@ -90,6 +120,9 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 		msgs = append(msgs, m)
 	}
 
+	DefaultMetrics.AddInFlight(e.name, e.config.Topic, float64(len(msgs)))
+	defer DefaultMetrics.AddInFlight(e.name, e.config.Topic, -float64(len(msgs)))
+
 	errorCount := 0
 
 	err := e.client.SendMessages(msgs)
@@ -98,6 +131,9 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 		if errors.As(err, &errs) {
 			errorCount = len(errs)
 
+			DefaultMetrics.AddProduceErrors(e.name, e.config.Topic, float64(errorCount))
+			DefaultMetrics.AddProduced(e.name, e.config.Topic, float64(len(msgs)-errorCount))
+
 			for _, producerError := range errs {
 				e.log.
 					WithError(producerError.Err).
@@ -106,12 +142,37 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.Decorated
 
 				return producerError
 			}
+
+			return err
 		}
 
+		DefaultMetrics.AddProduceErrors(e.name, e.config.Topic, float64(len(msgs)))
+
 		return err
 	}
 
+	DefaultMetrics.AddProduced(e.name, e.config.Topic, float64(len(msgs)))
+
 	e.log.WithField("count", len(msgs)-errorCount).Debug("Items written to Kafka")
 
 	return nil
 }
+
+// partitionKey returns the message key for the event, falling back to the event id when the event doesn't carry
+// the configured field.
+func (e *ItemExporter) partitionKey(event *xatu.DecoratedEvent) string {
+	switch e.config.PartitionKey {
+	case PartitionKeySlot:
+		if slot, ok := xatu.EventSlot(event); ok {
+			return strconv.FormatUint(slot, 10)
+		}
+	case PartitionKeyBlockRoot:
+		if root, ok := xatu.EventBlockRoot(event); ok {
+			return root
+		}
+	case PartitionKeyEventType:
+		return event.GetEvent().GetName().String()
+	}
+
+	return event.GetEvent().GetId()
+}
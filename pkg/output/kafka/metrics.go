@@ -0,0 +1,55 @@
+package kafka
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	DefaultMetrics = NewMetrics("xatu")
+)
+
+type Metrics struct {
+	produced      *prometheus.CounterVec
+	produceErrors *prometheus.CounterVec
+	inFlight      *prometheus.GaugeVec
+}
+
+func NewMetrics(namespace string) *Metrics {
+	if namespace != "" {
+		namespace += "_"
+	}
+
+	namespace += "output_kafka"
+
+	m := &Metrics{
+		produced: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:      "messages_produced_total",
+			Namespace: namespace,
+			Help:      "Number of messages successfully produced to kafka",
+		}, []string{"output_name", "topic"}),
+		produceErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:      "produce_errors_total",
+			Namespace: namespace,
+			Help:      "Number of messages that failed to be produced to kafka",
+		}, []string{"output_name", "topic"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:      "messages_in_flight",
+			Namespace: namespace,
+			Help:      "Number of messages sent to kafka that are waiting for an acknowledgement",
+		}, []string{"output_name", "topic"}),
+	}
+
+	prometheus.MustRegister(m.produced, m.produceErrors, m.inFlight)
+
+	return m
+}
+
+func (m *Metrics) AddProduced(name, topic string, count float64) {
+	m.produced.WithLabelValues(name, topic).Add(count)
+}
+
+func (m *Metrics) AddProduceErrors(name, topic string, count float64) {
+	m.produceErrors.WithLabelValues(name, topic).Add(count)
+}
+
+func (m *Metrics) AddInFlight(name, topic string, count float64) {
+	m.inFlight.WithLabelValues(name, topic).Add(count)
+}
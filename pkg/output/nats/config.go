@@ -0,0 +1,66 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+type Config struct {
+	// URL is a comma delimited list of NATS servers.
+	URL string `yaml:"url" default:"nats://127.0.0.1:4222"`
+	// Subject is the text/template used to build the subject each event is published to.
+	// Available fields are {{.EventName}} and {{.Network}}.
+	Subject string `yaml:"subject" default:"xatu.events"`
+	// CredentialsFile is an optional NATS credentials (.creds) file used to authenticate.
+	CredentialsFile string `yaml:"credentialsFile"`
+	// Token is an optional token used to authenticate.
+	Token string `yaml:"token"`
+
+	JetStream JetStreamConfig `yaml:"jetStream"`
+
+	MaxQueueSize       int           `yaml:"maxQueueSize" default:"51200"`
+	BatchTimeout       time.Duration `yaml:"batchTimeout" default:"5s"`
+	ExportTimeout      time.Duration `yaml:"exportTimeout" default:"30s"`
+	MaxExportBatchSize int           `yaml:"maxExportBatchSize" default:"512"`
+}
+
+type JetStreamConfig struct {
+	// Enabled publishes through JetStream and waits for the stream to acknowledge every event.
+	Enabled bool `yaml:"enabled" default:"false"`
+	// AckTimeout is how long to wait for JetStream to acknowledge a batch.
+	AckTimeout time.Duration `yaml:"ackTimeout" default:"10s"`
+	// MaxPending caps the number of unacknowledged asynchronous publishes.
+	MaxPending int `yaml:"maxPending" default:"4000"`
+}
+
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("url is required")
+	}
+
+	if c.Subject == "" {
+		return errors.New("subject is required")
+	}
+
+	if _, err := template.New("subject").Parse(c.Subject); err != nil {
+		return fmt.Errorf("invalid subject template: %w", err)
+	}
+
+	if c.CredentialsFile != "" && c.Token != "" {
+		return errors.New("only one of credentialsFile and token can be set")
+	}
+
+	if c.JetStream.Enabled {
+		if c.JetStream.AckTimeout <= 0 {
+			return errors.New("jetStream.ackTimeout must be greater than 0")
+		}
+
+		if c.JetStream.MaxPending <= 0 {
+			return errors.New("jetStream.maxPending must be greater than 0")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package nats
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/observability"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/nats-io/nats.go"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type subjectData struct {
+	EventName string
+	Network   string
+}
+
+type ItemExporter struct {
+	name    string
+	config  *Config
+	log     logrus.FieldLogger
+	conn    *nats.Conn
+	js      nats.JetStreamContext
+	subject *template.Template
+}
+
+func NewItemExporter(name string, config *Config, log logrus.FieldLogger) (*ItemExporter, error) {
+	log = log.WithField("output_name", name).WithField("output_type", SinkType)
+
+	subject, err := template.New("subject").Parse(config.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject template: %w", err)
+	}
+
+	opts := []nats.Option{
+		nats.Name(xatu.ImplementationLower() + "_" + name),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				log.WithError(err).Warn("Disconnected from NATS")
+			}
+		}),
+		nats.ReconnectHandler(func(conn *nats.Conn) {
+			log.WithField("server", conn.ConnectedUrl()).Info("Reconnected to NATS")
+		}),
+	}
+
+	if config.CredentialsFile != "" {
+		opts = append(opts, nats.UserCredentials(config.CredentialsFile))
+	}
+
+	if config.Token != "" {
+		opts = append(opts, nats.Token(config.Token))
+	}
+
+	conn, err := nats.Connect(config.URL, opts...)
+	if err != nil {
+		log.WithError(err).Error("Error while connecting to NATS")
+
+		return nil, err
+	}
+
+	exporter := &ItemExporter{
+		name:    name,
+		config:  config,
+		log:     log,
+		conn:    conn,
+		subject: subject,
+	}
+
+	if config.JetStream.Enabled {
+		js, err := conn.JetStream(nats.PublishAsyncMaxPending(config.JetStream.MaxPending))
+		if err != nil {
+			conn.Close()
+
+			return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+		}
+
+		exporter.js = js
+	}
+
+	return exporter, nil
+}
+
+func (e *ItemExporter) ExportItems(ctx context.Context, items []*xatu.DecoratedEvent) error {
+	_, span := observability.Tracer().Start(ctx, "NATSItemExporter.ExportItems", trace.WithAttributes(attribute.Int64("num_events", int64(len(items)))))
+	defer span.End()
+
+	e.log.WithField("events", len(items)).Debug("Sending batch of events to NATS sink")
+
+	if err := e.sendUpstream(ctx, items); err != nil {
+		e.log.
+			WithError(err).
+			WithField("num_events", len(items)).
+			Error("Failed to send events upstream")
+
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown waits for outstanding JetStream acknowledgements and then drains the connection so every published
+// message reaches the server before it is closed. The processor has already flushed any queued events.
+// Healthy reports whether the connection to the NATS server is established. It is reconnected in the
+// background, so this recovers on its own.
+func (e *ItemExporter) Healthy(ctx context.Context) error {
+	if !e.conn.IsConnected() {
+		return fmt.Errorf("nats connection is %s", e.conn.Status())
+	}
+
+	return nil
+}
+
+func (e *ItemExporter) Shutdown(ctx context.Context) error {
+	if e.js != nil {
+		select {
+		case <-e.js.PublishAsyncComplete():
+		case <-ctx.Done():
+			e.conn.Close()
+
+			return ctx.Err()
+		}
+	}
+
+	return e.conn.Drain()
+}
+
+func (e *ItemExporter) sendUpstream(ctx context.Context, items []*xatu.DecoratedEvent) error {
+	msgs := make([]*nats.Msg, 0, len(items))
+
+	for _, item := range items {
+		msg, err := e.newMsg(item)
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	if e.js != nil {
+		return e.publishJetStream(ctx, msgs)
+	}
+
+	return e.publish(ctx, msgs)
+}
+
+func (e *ItemExporter) newMsg(event *xatu.DecoratedEvent) (*nats.Msg, error) {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	network := event.GetMeta().GetClient().GetEthereum().GetNetwork().GetName()
+	if network == "" {
+		network = "unknown"
+	}
+
+	var subject bytes.Buffer
+	if err := e.subject.Execute(&subject, subjectData{
+		EventName: event.GetEvent().GetName().String(),
+		Network:   network,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to build subject: %w", err)
+	}
+
+	msg := nats.NewMsg(subject.String())
+	msg.Data = data
+
+	// Lets JetStream drop duplicates when a batch is retried.
+	msg.Header.Set(nats.MsgIdHdr, event.GetEvent().GetId())
+
+	return msg, nil
+}
+
+func (e *ItemExporter) publish(ctx context.Context, msgs []*nats.Msg) error {
+	start := time.Now()
+
+	for i, msg := range msgs {
+		if err := e.conn.PublishMsg(msg); err != nil {
+			DefaultMetrics.AddPublished(e.name, float64(i))
+			DefaultMetrics.AddPublishErrors(e.name, float64(len(msgs)-i))
+
+			return fmt.Errorf("failed to publish to %s: %w", msg.Subject, err)
+		}
+	}
+
+	// Core NATS has no acks, so wait for the server to process everything we've sent instead.
+	if err := e.conn.FlushWithContext(ctx); err != nil {
+		DefaultMetrics.AddPublishErrors(e.name, float64(len(msgs)))
+
+		return fmt.Errorf("failed to flush to nats: %w", err)
+	}
+
+	DefaultMetrics.ObserveAckLatency(e.name, time.Since(start).Seconds())
+	DefaultMetrics.AddPublished(e.name, float64(len(msgs)))
+
+	return nil
+}
+
+func (e *ItemExporter) publishJetStream(ctx context.Context, msgs []*nats.Msg) error {
+	ctx, cancel := context.WithTimeout(ctx, e.config.JetStream.AckTimeout)
+	defer cancel()
+
+	start := time.Now()
+
+	futures := make([]nats.PubAckFuture, 0, len(msgs))
+
+	for _, msg := range msgs {
+		future, err := e.js.PublishMsgAsync(msg)
+		if err != nil {
+			DefaultMetrics.AddPublishErrors(e.name, float64(len(msgs)-len(futures)))
+
+			return fmt.Errorf("failed to publish to %s: %w", msg.Subject, err)
+		}
+
+		futures = append(futures, future)
+	}
+
+	var firstErr error
+
+	failed := 0
+
+	for _, future := range futures {
+		select {
+		case <-future.Ok():
+			DefaultMetrics.ObserveAckLatency(e.name, time.Since(start).Seconds())
+		case err := <-future.Err():
+			failed++
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to publish to %s: %w", future.Msg().Subject, err)
+			}
+		case <-ctx.Done():
+			failed++
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("timed out waiting for jetstream ack: %w", ctx.Err())
+			}
+		}
+	}
+
+	DefaultMetrics.AddPublished(e.name, float64(len(futures)-failed))
+
+	if failed > 0 {
+		DefaultMetrics.AddPublishErrors(e.name, float64(failed))
+
+		return firstErr
+	}
+
+	return nil
+}
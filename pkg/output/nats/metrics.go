@@ -0,0 +1,56 @@
+package nats
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	DefaultMetrics = NewMetrics("xatu")
+)
+
+type Metrics struct {
+	published     *prometheus.CounterVec
+	publishErrors *prometheus.CounterVec
+	ackLatency    *prometheus.HistogramVec
+}
+
+func NewMetrics(namespace string) *Metrics {
+	if namespace != "" {
+		namespace += "_"
+	}
+
+	namespace += "output_nats"
+
+	m := &Metrics{
+		published: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:      "messages_published_total",
+			Namespace: namespace,
+			Help:      "Number of messages successfully published to nats",
+		}, []string{"output_name"}),
+		publishErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:      "publish_errors_total",
+			Namespace: namespace,
+			Help:      "Number of messages that failed to be published to nats",
+		}, []string{"output_name"}),
+		ackLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:      "ack_latency_seconds",
+			Namespace: namespace,
+			Help:      "Time taken for nats to acknowledge published messages. Measures the JetStream ack when enabled, otherwise the server flush round trip",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+		}, []string{"output_name"}),
+	}
+
+	prometheus.MustRegister(m.published, m.publishErrors, m.ackLatency)
+
+	return m
+}
+
+func (m *Metrics) AddPublished(name string, count float64) {
+	m.published.WithLabelValues(name).Add(count)
+}
+
+func (m *Metrics) AddPublishErrors(name string, count float64) {
+	m.publishErrors.WithLabelValues(name).Add(count)
+}
+
+func (m *Metrics) ObserveAckLatency(name string, seconds float64) {
+	m.ackLatency.WithLabelValues(name).Observe(seconds)
+}
@@ -0,0 +1,116 @@
+package nats
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/processor"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const SinkType = "nats"
+
+type NATS struct {
+	name     string
+	config   *Config
+	log      logrus.FieldLogger
+	proc     *processor.BatchItemProcessor[xatu.DecoratedEvent]
+	exporter *ItemExporter
+	filter   xatu.EventFilter
+}
+
+func New(name string, config *Config, log logrus.FieldLogger, filterConfig *xatu.EventFilterConfig, shippingMethod processor.ShippingMethod) (*NATS, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	exporter, err := NewItemExporter(name, config, log)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := xatu.NewEventFilter(filterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	proc, err := processor.NewBatchItemProcessor[xatu.DecoratedEvent](
+		exporter,
+		xatu.ImplementationLower()+"_output_"+SinkType+"_"+name,
+		log,
+		processor.WithMaxQueueSize(config.MaxQueueSize),
+		processor.WithBatchTimeout(config.BatchTimeout),
+		processor.WithExportTimeout(config.ExportTimeout),
+		processor.WithMaxExportBatchSize(config.MaxExportBatchSize),
+		processor.WithShippingMethod(shippingMethod),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NATS{
+		name:     name,
+		config:   config,
+		log:      log,
+		proc:     proc,
+		exporter: exporter,
+		filter:   filter,
+	}, nil
+}
+
+func (h *NATS) Name() string {
+	return h.name
+}
+
+func (h *NATS) Type() string {
+	return SinkType
+}
+
+// Healthy reports whether the upstream can accept events.
+func (h *NATS) Healthy(ctx context.Context) error {
+	return h.exporter.Healthy(ctx)
+}
+
+func (h *NATS) Start(ctx context.Context) error {
+	return nil
+}
+
+// Stop flushes any queued events and waits for them to be acknowledged before closing the connection.
+func (h *NATS) Stop(ctx context.Context) error {
+	return h.proc.Shutdown(ctx)
+}
+
+func (h *NATS) HandleNewDecoratedEvent(ctx context.Context, event *xatu.DecoratedEvent) error {
+	shouldBeDropped, err := h.filter.ShouldBeDropped(event)
+	if err != nil {
+		return err
+	}
+
+	if shouldBeDropped {
+		return nil
+	}
+
+	return h.proc.Write(ctx, []*xatu.DecoratedEvent{event})
+}
+
+func (h *NATS) HandleNewDecoratedEvents(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	filtered := []*xatu.DecoratedEvent{}
+
+	for _, event := range events {
+		shouldBeDropped, err := h.filter.ShouldBeDropped(event)
+		if err != nil {
+			return err
+		}
+
+		if !shouldBeDropped {
+			filtered = append(filtered, event)
+		}
+	}
+
+	return h.proc.Write(ctx, filtered)
+}
@@ -13,3 +13,17 @@ func (r *RawMessage) UnmarshalYAML(unmarshal func(interface{}) error) error {
 func (r *RawMessage) Unmarshal(v interface{}) error {
 	return r.unmarshal(v)
 }
+
+// MarshalYAML re-encodes the raw config so the sink config is included when the parent config is printed.
+func (r *RawMessage) MarshalYAML() (interface{}, error) {
+	if r == nil || r.unmarshal == nil {
+		return nil, nil
+	}
+
+	var v interface{}
+	if err := r.unmarshal(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
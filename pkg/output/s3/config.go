@@ -0,0 +1,71 @@
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatNDJSON  Format = "ndjson"
+	FormatParquet Format = "parquet"
+)
+
+type Compression string
+
+const (
+	CompressionNone Compression = "none"
+	CompressionGzip Compression = "gzip"
+)
+
+type Config struct {
+	Bucket string `yaml:"bucket"`
+	// Region is optional when it can be resolved from the environment.
+	Region string `yaml:"region"`
+	// Endpoint overrides the S3 endpoint, e.g. for S3 compatible stores such as minio.
+	Endpoint     string `yaml:"endpoint"`
+	UsePathStyle bool   `yaml:"usePathStyle" default:"false"`
+	Prefix       string `yaml:"prefix"`
+	// KeyTemplate is the text/template used to build object keys. The file extension is appended automatically.
+	KeyTemplate        string        `yaml:"keyTemplate" default:"{{.Network}}/{{.EventName}}/{{.StartSlot}}-{{.EndSlot}}_{{.Timestamp}}"`
+	Format             Format        `yaml:"format" default:"ndjson"`
+	Compression        Compression   `yaml:"compression" default:"gzip"`
+	MaxEventsPerObject int           `yaml:"maxEventsPerObject" default:"10000"`
+	FlushInterval      time.Duration `yaml:"flushInterval" default:"5m"`
+	UploadTimeout      time.Duration `yaml:"uploadTimeout" default:"1m"`
+}
+
+func (c *Config) Validate() error {
+	if c.Bucket == "" {
+		return errors.New("bucket is required")
+	}
+
+	switch c.Format {
+	case FormatNDJSON, FormatParquet:
+	default:
+		return fmt.Errorf("unsupported format: %s", c.Format)
+	}
+
+	switch c.Compression {
+	case CompressionNone, CompressionGzip:
+	default:
+		return fmt.Errorf("unsupported compression: %s", c.Compression)
+	}
+
+	if c.MaxEventsPerObject <= 0 {
+		return errors.New("maxEventsPerObject must be greater than 0")
+	}
+
+	if c.FlushInterval <= 0 {
+		return errors.New("flushInterval must be greater than 0")
+	}
+
+	if _, err := template.New("key").Parse(c.KeyTemplate); err != nil {
+		return fmt.Errorf("invalid keyTemplate: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package s3
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// parquetRow flattens a decorated event into the columns most commonly filtered on, keeping the full event as JSON.
+type parquetRow struct {
+	EventName     string `parquet:"name=event_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	EventDateTime int64  `parquet:"name=event_date_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	ID            string `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Network       string `parquet:"name=network, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Slot          *int64 `parquet:"name=slot, type=INT64, repetitiontype=OPTIONAL"`
+	Event         string `parquet:"name=event, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+func encode(config *Config, events []*xatu.DecoratedEvent) ([]byte, error) {
+	if config.Format == FormatParquet {
+		return encodeParquet(events, config.Compression)
+	}
+
+	return encodeNDJSON(events, config.Compression)
+}
+
+func encodeNDJSON(events []*xatu.DecoratedEvent, compression Compression) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var w io.Writer = &buf
+
+	var gz *gzip.Writer
+	if compression == CompressionGzip {
+		gz = gzip.NewWriter(&buf)
+		w = gz
+	}
+
+	for _, event := range events {
+		line, err := protojson.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return nil, err
+		}
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func encodeParquet(events []*xatu.DecoratedEvent, compression Compression) ([]byte, error) {
+	var buf bytes.Buffer
+
+	pw, err := writer.NewParquetWriterFromWriter(&buf, new(parquetRow), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	pw.CompressionType = parquet.CompressionCodec_UNCOMPRESSED
+	if compression == CompressionGzip {
+		pw.CompressionType = parquet.CompressionCodec_GZIP
+	}
+
+	for _, event := range events {
+		data, err := protojson.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+
+		row := parquetRow{
+			EventName:     event.GetEvent().GetName().String(),
+			EventDateTime: event.GetEvent().GetDateTime().AsTime().UnixMilli(),
+			ID:            event.GetEvent().GetId(),
+			Network:       event.GetMeta().GetClient().GetEthereum().GetNetwork().GetName(),
+			Event:         string(data),
+		}
+
+		if slot, ok := xatu.EventSlot(event); ok {
+			s := int64(slot)
+			row.Slot = &s
+		}
+
+		if err := pw.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := pw.WriteStop(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,204 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/ethpandaops/xatu/pkg/observability"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type objectBuffer struct {
+	events  []*xatu.DecoratedEvent
+	minSlot *uint64
+	maxSlot *uint64
+}
+
+func (b *objectBuffer) add(event *xatu.DecoratedEvent) {
+	b.events = append(b.events, event)
+
+	slot, ok := xatu.EventSlot(event)
+	if !ok {
+		return
+	}
+
+	if b.minSlot == nil || slot < *b.minSlot {
+		s := slot
+		b.minSlot = &s
+	}
+
+	if b.maxSlot == nil || slot > *b.maxSlot {
+		s := slot
+		b.maxSlot = &s
+	}
+}
+
+type ItemExporter struct {
+	config *Config
+	log    logrus.FieldLogger
+	client *awss3.Client
+	keys   *keyBuilder
+
+	mu      sync.Mutex
+	buffers map[partition]*objectBuffer
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewItemExporter(name string, config *Config, log logrus.FieldLogger) (*ItemExporter, error) {
+	keys, err := newKeyBuilder(config)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []func(*awsconfig.LoadOptions) error{}
+	if config.Region != "" {
+		opts = append(opts, awsconfig.WithRegion(config.Region))
+	}
+
+	// Credentials are resolved from the standard AWS chain: env vars, shared config, web identity and instance roles.
+	awsCfg, err := awsconfig.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load aws config")
+	}
+
+	client := awss3.NewFromConfig(awsCfg, func(o *awss3.Options) {
+		if config.Endpoint != "" {
+			o.BaseEndpoint = aws.String(config.Endpoint)
+		}
+
+		o.UsePathStyle = config.UsePathStyle
+	})
+
+	e := &ItemExporter{
+		config:  config,
+		log:     log.WithField("output_name", name).WithField("output_type", SinkType),
+		client:  client,
+		keys:    keys,
+		buffers: make(map[partition]*objectBuffer),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go e.flushLoop()
+
+	return e, nil
+}
+
+func (e *ItemExporter) ExportItems(ctx context.Context, items []*xatu.DecoratedEvent) error {
+	_, span := observability.Tracer().Start(ctx, "S3ItemExporter.ExportItems", trace.WithAttributes(attribute.Int64("num_events", int64(len(items)))))
+	defer span.End()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, event := range items {
+		p := partitionForEvent(event)
+
+		buf, ok := e.buffers[p]
+		if !ok {
+			buf = &objectBuffer{}
+			e.buffers[p] = buf
+		}
+
+		buf.add(event)
+
+		if len(buf.events) < e.config.MaxEventsPerObject {
+			continue
+		}
+
+		// The events are already buffered, so on failure we keep them for the next flush rather than returning
+		// an error and having the processor hand us the same events again.
+		if err := e.upload(ctx, p, buf); err != nil {
+			continue
+		}
+
+		delete(e.buffers, p)
+	}
+
+	return nil
+}
+
+// Shutdown uploads any partially filled objects.
+func (e *ItemExporter) Shutdown(ctx context.Context) error {
+	close(e.stop)
+	<-e.done
+
+	return e.flush(ctx)
+}
+
+func (e *ItemExporter) flushLoop() {
+	defer close(e.done)
+
+	ticker := time.NewTicker(e.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.stop:
+			return
+		case <-ticker.C:
+			if err := e.flush(context.Background()); err != nil {
+				e.log.WithError(err).Error("Failed to flush objects to s3")
+			}
+		}
+	}
+}
+
+func (e *ItemExporter) flush(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for p, buf := range e.buffers {
+		if err := e.upload(ctx, p, buf); err != nil {
+			return err
+		}
+
+		delete(e.buffers, p)
+	}
+
+	return nil
+}
+
+func (e *ItemExporter) upload(ctx context.Context, p partition, buf *objectBuffer) error {
+	key, err := e.keys.build(p, buf.minSlot, buf.maxSlot, time.Now())
+	if err != nil {
+		return err
+	}
+
+	body, err := encode(e.config, buf.events)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode events")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.config.UploadTimeout)
+	defer cancel()
+
+	if _, err := e.client.PutObject(ctx, &awss3.PutObjectInput{
+		Bucket: aws.String(e.config.Bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(body),
+	}); err != nil {
+		e.log.
+			WithError(err).
+			WithField("key", key).
+			WithField("num_events", len(buf.events)).
+			Error("Failed to upload object to s3")
+
+		return err
+	}
+
+	e.log.WithField("key", key).WithField("num_events", len(buf.events)).Debug("Uploaded object to s3")
+
+	return nil
+}
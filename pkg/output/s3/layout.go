@@ -0,0 +1,86 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+)
+
+const unknownSlot = "unknown"
+
+// partition identifies the object a decorated event is written to.
+type partition struct {
+	network   string
+	eventName string
+}
+
+type keyData struct {
+	Network   string
+	EventName string
+	StartSlot string
+	EndSlot   string
+	Timestamp int64
+}
+
+type keyBuilder struct {
+	prefix string
+	tmpl   *template.Template
+	ext    string
+}
+
+func newKeyBuilder(config *Config) (*keyBuilder, error) {
+	tmpl, err := template.New("key").Parse(config.KeyTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := "." + string(config.Format)
+	if config.Format == FormatNDJSON && config.Compression == CompressionGzip {
+		ext += ".gz"
+	}
+
+	return &keyBuilder{
+		prefix: config.Prefix,
+		tmpl:   tmpl,
+		ext:    ext,
+	}, nil
+}
+
+func (k *keyBuilder) build(p partition, minSlot, maxSlot *uint64, now time.Time) (string, error) {
+	data := keyData{
+		Network:   p.network,
+		EventName: p.eventName,
+		StartSlot: unknownSlot,
+		EndSlot:   unknownSlot,
+		Timestamp: now.UnixNano(),
+	}
+
+	if minSlot != nil && maxSlot != nil {
+		data.StartSlot = strconv.FormatUint(*minSlot, 10)
+		data.EndSlot = strconv.FormatUint(*maxSlot, 10)
+	}
+
+	var buf bytes.Buffer
+	if err := k.tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to build object key: %w", err)
+	}
+
+	return path.Join(k.prefix, buf.String()) + k.ext, nil
+}
+
+func partitionForEvent(event *xatu.DecoratedEvent) partition {
+	network := event.GetMeta().GetClient().GetEthereum().GetNetwork().GetName()
+	if network == "" {
+		network = "unknown"
+	}
+
+	return partition{
+		network:   network,
+		eventName: event.GetEvent().GetName().String(),
+	}
+}
@@ -2,10 +2,16 @@ package output
 
 import (
 	"context"
+	"errors"
 
+	"github.com/ethpandaops/xatu/pkg/output/clickhouse"
+	"github.com/ethpandaops/xatu/pkg/output/file"
 	"github.com/ethpandaops/xatu/pkg/output/http"
 	"github.com/ethpandaops/xatu/pkg/output/kafka"
+	"github.com/ethpandaops/xatu/pkg/output/nats"
+	"github.com/ethpandaops/xatu/pkg/output/s3"
 	"github.com/ethpandaops/xatu/pkg/output/stdout"
+	"github.com/ethpandaops/xatu/pkg/output/websocket"
 	xatuSink "github.com/ethpandaops/xatu/pkg/output/xatu"
 	"github.com/ethpandaops/xatu/pkg/proto/xatu"
 )
@@ -13,13 +19,30 @@ import (
 type SinkType string
 
 const (
-	SinkTypeUnknown SinkType = "unknown"
-	SinkTypeHTTP    SinkType = http.SinkType
-	SinkTypeStdOut  SinkType = stdout.SinkType
-	SinkTypeXatu    SinkType = xatuSink.SinkType
-	SinkTypeKafka   SinkType = kafka.SinkType
+	SinkTypeUnknown    SinkType = "unknown"
+	SinkTypeHTTP       SinkType = http.SinkType
+	SinkTypeStdOut     SinkType = stdout.SinkType
+	SinkTypeXatu       SinkType = xatuSink.SinkType
+	SinkTypeKafka      SinkType = kafka.SinkType
+	SinkTypeFile       SinkType = file.SinkType
+	SinkTypeWebSocket  SinkType = websocket.SinkType
+	SinkTypeS3         SinkType = s3.SinkType
+	SinkTypeClickHouse SinkType = clickhouse.SinkType
+	SinkTypeNATS       SinkType = nats.SinkType
 )
 
+// ErrSinkBusy is returned by HandleNewDecoratedEvents when a sink can't accept more events until it has
+// drained what it already holds. None of the events were accepted, so callers should retry the same events later.
+var ErrSinkBusy = errors.New("sink is busy")
+
+// IsPermanent reports whether a sink marked err as permanent, meaning handing it the same events again will fail
+// the same way, e.g. the http sink receiving a 400 for a batch.
+func IsPermanent(err error) bool {
+	var permanent interface{ Permanent() bool }
+
+	return errors.As(err, &permanent) && permanent.Permanent()
+}
+
 type Sink interface {
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
@@ -0,0 +1,87 @@
+package websocket
+
+import (
+	"sync"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+type client struct {
+	conn *ws.Conn
+	log  logrus.FieldLogger
+
+	send chan []byte
+
+	writeTimeout time.Duration
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func newClient(conn *ws.Conn, bufferSize int, writeTimeout time.Duration, log logrus.FieldLogger) *client {
+	return &client{
+		conn:         conn,
+		log:          log.WithField("remote_addr", conn.RemoteAddr().String()),
+		send:         make(chan []byte, bufferSize),
+		writeTimeout: writeTimeout,
+		done:         make(chan struct{}),
+	}
+}
+
+// enqueue buffers the frame for the client, returning false if the client's buffer is full.
+func (c *client) enqueue(frame []byte) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	select {
+	case c.send <- frame:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+
+		_ = c.conn.Close()
+	})
+}
+
+func (c *client) writeLoop() {
+	defer c.close()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case frame := <-c.send:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout)); err != nil {
+				return
+			}
+
+			if err := c.conn.WriteMessage(ws.TextMessage, frame); err != nil {
+				c.log.WithError(err).Debug("Failed to write to websocket client")
+
+				return
+			}
+		}
+	}
+}
+
+// readLoop discards anything the client sends and closes the client once the connection is gone.
+func (c *client) readLoop() {
+	defer c.close()
+
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
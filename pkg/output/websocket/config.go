@@ -0,0 +1,29 @@
+package websocket
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	// Address is the address the websocket server listens on.
+	Address string `yaml:"address"`
+	// Path is the http path clients connect to.
+	Path string `yaml:"path" default:"/"`
+	// ClientBufferSize is the number of events buffered per client. Clients that fall further behind are disconnected.
+	ClientBufferSize int `yaml:"clientBufferSize" default:"1000"`
+	// WriteTimeout is the maximum duration for writing a single frame to a client.
+	WriteTimeout time.Duration `yaml:"writeTimeout" default:"10s"`
+}
+
+func (c *Config) Validate() error {
+	if c.Address == "" {
+		return errors.New("address is required")
+	}
+
+	if c.ClientBufferSize <= 0 {
+		return errors.New("clientBufferSize must be greater than 0")
+	}
+
+	return nil
+}
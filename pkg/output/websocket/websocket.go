@@ -0,0 +1,179 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/processor"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	ws "github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const SinkType = "websocket"
+
+type WebSocket struct {
+	name   string
+	config *Config
+	log    logrus.FieldLogger
+	filter xatu.EventFilter
+
+	upgrader ws.Upgrader
+	server   *http.Server
+
+	mu      sync.Mutex
+	clients map[*client]struct{}
+}
+
+func New(name string, config *Config, log logrus.FieldLogger, filterConfig *xatu.EventFilterConfig, _ processor.ShippingMethod) (*WebSocket, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter, err := xatu.NewEventFilter(filterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebSocket{
+		name:    name,
+		config:  config,
+		log:     log.WithField("output_name", name).WithField("output_type", SinkType),
+		filter:  filter,
+		clients: make(map[*client]struct{}),
+		upgrader: ws.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}, nil
+}
+
+func (w *WebSocket) Name() string {
+	return w.name
+}
+
+func (w *WebSocket) Type() string {
+	return SinkType
+}
+
+func (w *WebSocket) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(w.config.Path, w.handleConnection)
+
+	listener, err := net.Listen("tcp", w.config.Address)
+	if err != nil {
+		return err
+	}
+
+	w.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 15 * time.Second,
+	}
+
+	go func() {
+		if err := w.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			w.log.WithError(err).Error("Websocket server stopped unexpectedly")
+		}
+	}()
+
+	w.log.WithField("address", w.config.Address).Info("Websocket sink listening")
+
+	return nil
+}
+
+func (w *WebSocket) Stop(ctx context.Context) error {
+	var err error
+
+	if w.server != nil {
+		err = w.server.Shutdown(ctx)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for c := range w.clients {
+		c.close()
+
+		delete(w.clients, c)
+	}
+
+	return err
+}
+
+func (w *WebSocket) HandleNewDecoratedEvent(ctx context.Context, event *xatu.DecoratedEvent) error {
+	return w.HandleNewDecoratedEvents(ctx, []*xatu.DecoratedEvent{event})
+}
+
+func (w *WebSocket) HandleNewDecoratedEvents(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	for _, event := range events {
+		shouldBeDropped, err := w.filter.ShouldBeDropped(event)
+		if err != nil {
+			return err
+		}
+
+		if shouldBeDropped {
+			continue
+		}
+
+		frame, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		w.broadcast(frame)
+	}
+
+	return nil
+}
+
+func (w *WebSocket) broadcast(frame []byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for c := range w.clients {
+		if c.enqueue(frame) {
+			continue
+		}
+
+		c.log.Warn("Websocket client buffer is full, disconnecting client")
+
+		c.close()
+
+		delete(w.clients, c)
+	}
+}
+
+func (w *WebSocket) handleConnection(rw http.ResponseWriter, r *http.Request) {
+	conn, err := w.upgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		w.log.WithError(err).Debug("Failed to upgrade websocket connection")
+
+		return
+	}
+
+	c := newClient(conn, w.config.ClientBufferSize, w.config.WriteTimeout, w.log)
+
+	w.mu.Lock()
+	w.clients[c] = struct{}{}
+	w.mu.Unlock()
+
+	c.log.Debug("Websocket client connected")
+
+	go c.writeLoop()
+
+	c.readLoop()
+
+	w.mu.Lock()
+	delete(w.clients, c)
+	w.mu.Unlock()
+
+	c.log.Debug("Websocket client disconnected")
+}
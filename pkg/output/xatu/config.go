@@ -2,12 +2,16 @@ package xatu
 
 import (
 	"errors"
+	"fmt"
 	"time"
+
+	"github.com/ethpandaops/xatu/pkg/output/auth"
 )
 
 type Config struct {
 	Address            string            `yaml:"address"`
 	Headers            map[string]string `yaml:"headers"`
+	Auth               auth.Config       `yaml:"auth"`
 	TLS                bool              `yaml:"tls" default:"false"`
 	MaxQueueSize       int               `yaml:"maxQueueSize" default:"51200"`
 	BatchTimeout       time.Duration     `yaml:"batchTimeout" default:"5s"`
@@ -21,5 +25,9 @@ func (c *Config) Validate() error {
 		return errors.New("address is required")
 	}
 
+	if err := c.Auth.Validate(); err != nil {
+		return fmt.Errorf("invalid auth config: %w", err)
+	}
+
 	return nil
 }
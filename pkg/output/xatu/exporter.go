@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
@@ -77,6 +78,19 @@ func (e ItemExporter) ExportItems(ctx context.Context, items []*pb.DecoratedEven
 	return nil
 }
 
+// Healthy reports whether the gRPC connection to the server is usable. An idle connection is asked to connect
+// and counted as healthy, as it only dials when there is something to send.
+func (e ItemExporter) Healthy(ctx context.Context) error {
+	switch state := e.conn.GetState(); state {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("grpc connection is %s", state)
+	case connectivity.Idle:
+		e.conn.Connect()
+	}
+
+	return nil
+}
+
 func (e ItemExporter) Shutdown(ctx context.Context) error {
 	return e.conn.Close()
 }
@@ -87,6 +101,16 @@ func (e *ItemExporter) sendUpstream(ctx context.Context, items []*pb.DecoratedEv
 	}
 
 	md := metadata.New(e.config.Headers)
+
+	authorization, err := e.config.Auth.AuthorizationHeader()
+	if err != nil {
+		return err
+	}
+
+	if authorization != "" {
+		md.Set("authorization", authorization)
+	}
+
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	rsp, err := e.client.CreateEvents(ctx, req, grpc.UseCompressor(gzip.Name))
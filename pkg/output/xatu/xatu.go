@@ -12,11 +12,12 @@ import (
 const SinkType = "xatu"
 
 type Xatu struct {
-	name   string
-	config *Config
-	log    logrus.FieldLogger
-	proc   *processor.BatchItemProcessor[xatu.DecoratedEvent]
-	filter xatu.EventFilter
+	name     string
+	config   *Config
+	log      logrus.FieldLogger
+	proc     *processor.BatchItemProcessor[xatu.DecoratedEvent]
+	exporter ItemExporter
+	filter   xatu.EventFilter
 }
 
 func New(name string, config *Config, log logrus.FieldLogger, filterConfig *xatu.EventFilterConfig, shippingMethod processor.ShippingMethod) (*Xatu, error) {
@@ -53,11 +54,12 @@ func New(name string, config *Config, log logrus.FieldLogger, filterConfig *xatu
 	}
 
 	return &Xatu{
-		name:   name,
-		config: config,
-		log:    log,
-		proc:   proc,
-		filter: filter,
+		name:     name,
+		config:   config,
+		log:      log,
+		proc:     proc,
+		exporter: exporter,
+		filter:   filter,
 	}, nil
 }
 
@@ -69,6 +71,11 @@ func (h *Xatu) Name() string {
 	return h.name
 }
 
+// Healthy reports whether the upstream can accept events.
+func (h *Xatu) Healthy(ctx context.Context) error {
+	return h.exporter.Healthy(ctx)
+}
+
 func (h *Xatu) Start(ctx context.Context) error {
 	return nil
 }
@@ -1458,6 +1458,93 @@ func (x *SyncAggregate) GetSyncCommitteeSignature() string {
 	return ""
 }
 
+type BlockReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposerIndex     *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=proposer_index,proto3" json:"proposer_index,omitempty"`
+	Total             *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Attestations      *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	SyncAggregate     *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=sync_aggregate,proto3" json:"sync_aggregate,omitempty"`
+	ProposerSlashings *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=proposer_slashings,proto3" json:"proposer_slashings,omitempty"`
+	AttesterSlashings *wrapperspb.UInt64Value `protobuf:"bytes,6,opt,name=attester_slashings,proto3" json:"attester_slashings,omitempty"`
+}
+
+func (x *BlockReward) Reset() {
+	*x = BlockReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockReward) ProtoMessage() {}
+
+func (x *BlockReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockReward.ProtoReflect.Descriptor instead.
+func (*BlockReward) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_eth_v1_beacon_block_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BlockReward) GetProposerIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return nil
+}
+
+func (x *BlockReward) GetTotal() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *BlockReward) GetAttestations() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+func (x *BlockReward) GetSyncAggregate() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.SyncAggregate
+	}
+	return nil
+}
+
+func (x *BlockReward) GetProposerSlashings() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.ProposerSlashings
+	}
+	return nil
+}
+
+func (x *BlockReward) GetAttesterSlashings() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.AttesterSlashings
+	}
+	return nil
+}
+
 type Deposit_Data struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1472,7 +1559,7 @@ type Deposit_Data struct {
 func (x *Deposit_Data) Reset() {
 	*x = Deposit_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[23]
+		mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1485,7 +1572,7 @@ func (x *Deposit_Data) String() string {
 func (*Deposit_Data) ProtoMessage() {}
 
 func (x *Deposit_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[23]
+	mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,7 +1630,7 @@ type DepositV2_Data struct {
 func (x *DepositV2_Data) Reset() {
 	*x = DepositV2_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[24]
+		mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1556,7 +1643,7 @@ func (x *DepositV2_Data) String() string {
 func (*DepositV2_Data) ProtoMessage() {}
 
 func (x *DepositV2_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[24]
+	mi := &file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,11 +1948,37 @@ var file_pkg_proto_eth_v1_beacon_block_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
 	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x18, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
-	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x74, 0x68, 0x70, 0x61, 0x6e, 0x64,
-	0x61, 0x6f, 0x70, 0x73, 0x2f, 0x78, 0x61, 0x74, 0x75, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xab, 0x03, 0x0a, 0x0b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x44, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x12,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4c, 0x0a, 0x12, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x74, 0x68, 0x70, 0x61, 0x6e, 0x64, 0x61, 0x6f,
+	0x70, 0x73, 0x2f, 0x78, 0x61, 0x74, 0x75, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1880,7 +1993,7 @@ func file_pkg_proto_eth_v1_beacon_block_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_eth_v1_beacon_block_proto_rawDescData
 }
 
-var file_pkg_proto_eth_v1_beacon_block_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_pkg_proto_eth_v1_beacon_block_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_pkg_proto_eth_v1_beacon_block_proto_goTypes = []interface{}{
 	(*BeaconBlock)(nil),               // 0: xatu.eth.v1.BeaconBlock
 	(*BeaconBlockV2)(nil),             // 1: xatu.eth.v1.BeaconBlockV2
@@ -1905,24 +2018,25 @@ var file_pkg_proto_eth_v1_beacon_block_proto_goTypes = []interface{}{
 	(*IndexedAttestation)(nil),        // 20: xatu.eth.v1.IndexedAttestation
 	(*IndexedAttestationV2)(nil),      // 21: xatu.eth.v1.IndexedAttestationV2
 	(*SyncAggregate)(nil),             // 22: xatu.eth.v1.SyncAggregate
-	(*Deposit_Data)(nil),              // 23: xatu.eth.v1.Deposit.Data
-	(*DepositV2_Data)(nil),            // 24: xatu.eth.v1.DepositV2.Data
-	(*wrapperspb.UInt64Value)(nil),    // 25: google.protobuf.UInt64Value
-	(*Attestation)(nil),               // 26: xatu.eth.v1.Attestation
-	(*AttestationData)(nil),           // 27: xatu.eth.v1.AttestationData
-	(*AttestationDataV2)(nil),         // 28: xatu.eth.v1.AttestationDataV2
+	(*BlockReward)(nil),               // 23: xatu.eth.v1.BlockReward
+	(*Deposit_Data)(nil),              // 24: xatu.eth.v1.Deposit.Data
+	(*DepositV2_Data)(nil),            // 25: xatu.eth.v1.DepositV2.Data
+	(*wrapperspb.UInt64Value)(nil),    // 26: google.protobuf.UInt64Value
+	(*Attestation)(nil),               // 27: xatu.eth.v1.Attestation
+	(*AttestationData)(nil),           // 28: xatu.eth.v1.AttestationData
+	(*AttestationDataV2)(nil),         // 29: xatu.eth.v1.AttestationDataV2
 }
 var file_pkg_proto_eth_v1_beacon_block_proto_depIdxs = []int32{
 	4,  // 0: xatu.eth.v1.BeaconBlock.body:type_name -> xatu.eth.v1.BeaconBlockBody
-	25, // 1: xatu.eth.v1.BeaconBlockV2.slot:type_name -> google.protobuf.UInt64Value
-	25, // 2: xatu.eth.v1.BeaconBlockV2.proposer_index:type_name -> google.protobuf.UInt64Value
+	26, // 1: xatu.eth.v1.BeaconBlockV2.slot:type_name -> google.protobuf.UInt64Value
+	26, // 2: xatu.eth.v1.BeaconBlockV2.proposer_index:type_name -> google.protobuf.UInt64Value
 	4,  // 3: xatu.eth.v1.BeaconBlockV2.body:type_name -> xatu.eth.v1.BeaconBlockBody
 	0,  // 4: xatu.eth.v1.SignedBeaconBlock.block:type_name -> xatu.eth.v1.BeaconBlock
 	1,  // 5: xatu.eth.v1.SignedBeaconBlockV2.block:type_name -> xatu.eth.v1.BeaconBlockV2
 	15, // 6: xatu.eth.v1.BeaconBlockBody.eth1_data:type_name -> xatu.eth.v1.Eth1Data
 	5,  // 7: xatu.eth.v1.BeaconBlockBody.proposer_slashings:type_name -> xatu.eth.v1.ProposerSlashing
 	7,  // 8: xatu.eth.v1.BeaconBlockBody.attester_slashings:type_name -> xatu.eth.v1.AttesterSlashing
-	26, // 9: xatu.eth.v1.BeaconBlockBody.attestations:type_name -> xatu.eth.v1.Attestation
+	27, // 9: xatu.eth.v1.BeaconBlockBody.attestations:type_name -> xatu.eth.v1.Attestation
 	9,  // 10: xatu.eth.v1.BeaconBlockBody.deposits:type_name -> xatu.eth.v1.Deposit
 	13, // 11: xatu.eth.v1.BeaconBlockBody.voluntary_exits:type_name -> xatu.eth.v1.SignedVoluntaryExit
 	18, // 12: xatu.eth.v1.ProposerSlashing.signed_header_1:type_name -> xatu.eth.v1.SignedBeaconBlockHeader
@@ -1933,25 +2047,31 @@ var file_pkg_proto_eth_v1_beacon_block_proto_depIdxs = []int32{
 	20, // 17: xatu.eth.v1.AttesterSlashing.attestation_2:type_name -> xatu.eth.v1.IndexedAttestation
 	21, // 18: xatu.eth.v1.AttesterSlashingV2.attestation_1:type_name -> xatu.eth.v1.IndexedAttestationV2
 	21, // 19: xatu.eth.v1.AttesterSlashingV2.attestation_2:type_name -> xatu.eth.v1.IndexedAttestationV2
-	23, // 20: xatu.eth.v1.Deposit.data:type_name -> xatu.eth.v1.Deposit.Data
-	24, // 21: xatu.eth.v1.DepositV2.data:type_name -> xatu.eth.v1.DepositV2.Data
-	25, // 22: xatu.eth.v1.VoluntaryExitV2.epoch:type_name -> google.protobuf.UInt64Value
-	25, // 23: xatu.eth.v1.VoluntaryExitV2.validator_index:type_name -> google.protobuf.UInt64Value
+	24, // 20: xatu.eth.v1.Deposit.data:type_name -> xatu.eth.v1.Deposit.Data
+	25, // 21: xatu.eth.v1.DepositV2.data:type_name -> xatu.eth.v1.DepositV2.Data
+	26, // 22: xatu.eth.v1.VoluntaryExitV2.epoch:type_name -> google.protobuf.UInt64Value
+	26, // 23: xatu.eth.v1.VoluntaryExitV2.validator_index:type_name -> google.protobuf.UInt64Value
 	11, // 24: xatu.eth.v1.SignedVoluntaryExit.message:type_name -> xatu.eth.v1.VoluntaryExit
 	12, // 25: xatu.eth.v1.SignedVoluntaryExitV2.message:type_name -> xatu.eth.v1.VoluntaryExitV2
-	25, // 26: xatu.eth.v1.BeaconBlockHeaderV2.slot:type_name -> google.protobuf.UInt64Value
-	25, // 27: xatu.eth.v1.BeaconBlockHeaderV2.proposer_index:type_name -> google.protobuf.UInt64Value
+	26, // 26: xatu.eth.v1.BeaconBlockHeaderV2.slot:type_name -> google.protobuf.UInt64Value
+	26, // 27: xatu.eth.v1.BeaconBlockHeaderV2.proposer_index:type_name -> google.protobuf.UInt64Value
 	16, // 28: xatu.eth.v1.SignedBeaconBlockHeader.message:type_name -> xatu.eth.v1.BeaconBlockHeader
 	17, // 29: xatu.eth.v1.SignedBeaconBlockHeaderV2.message:type_name -> xatu.eth.v1.BeaconBlockHeaderV2
-	27, // 30: xatu.eth.v1.IndexedAttestation.data:type_name -> xatu.eth.v1.AttestationData
-	25, // 31: xatu.eth.v1.IndexedAttestationV2.attesting_indices:type_name -> google.protobuf.UInt64Value
-	28, // 32: xatu.eth.v1.IndexedAttestationV2.data:type_name -> xatu.eth.v1.AttestationDataV2
-	25, // 33: xatu.eth.v1.DepositV2.Data.amount:type_name -> google.protobuf.UInt64Value
-	34, // [34:34] is the sub-list for method output_type
-	34, // [34:34] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	28, // 30: xatu.eth.v1.IndexedAttestation.data:type_name -> xatu.eth.v1.AttestationData
+	26, // 31: xatu.eth.v1.IndexedAttestationV2.attesting_indices:type_name -> google.protobuf.UInt64Value
+	29, // 32: xatu.eth.v1.IndexedAttestationV2.data:type_name -> xatu.eth.v1.AttestationDataV2
+	26, // 33: xatu.eth.v1.BlockReward.proposer_index:type_name -> google.protobuf.UInt64Value
+	26, // 34: xatu.eth.v1.BlockReward.total:type_name -> google.protobuf.UInt64Value
+	26, // 35: xatu.eth.v1.BlockReward.attestations:type_name -> google.protobuf.UInt64Value
+	26, // 36: xatu.eth.v1.BlockReward.sync_aggregate:type_name -> google.protobuf.UInt64Value
+	26, // 37: xatu.eth.v1.BlockReward.proposer_slashings:type_name -> google.protobuf.UInt64Value
+	26, // 38: xatu.eth.v1.BlockReward.attester_slashings:type_name -> google.protobuf.UInt64Value
+	26, // 39: xatu.eth.v1.DepositV2.Data.amount:type_name -> google.protobuf.UInt64Value
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_eth_v1_beacon_block_proto_init() }
@@ -2238,7 +2358,7 @@ func file_pkg_proto_eth_v1_beacon_block_proto_init() {
 			}
 		}
 		file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deposit_Data); i {
+			switch v := v.(*BlockReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2250,6 +2370,18 @@ func file_pkg_proto_eth_v1_beacon_block_proto_init() {
 			}
 		}
 		file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deposit_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_eth_v1_beacon_block_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositV2_Data); i {
 			case 0:
 				return &v.state
@@ -2268,7 +2400,7 @@ func file_pkg_proto_eth_v1_beacon_block_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_eth_v1_beacon_block_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
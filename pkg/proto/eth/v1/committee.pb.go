@@ -87,6 +87,168 @@ func (x *Committee) GetValidators() []*wrapperspb.UInt64Value {
 	return nil
 }
 
+// ValidatorParticipation is a validator's attestation duty outcome for an epoch.
+type ValidatorParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndex *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=validator_index,proto3" json:"validator_index,omitempty"`
+	// Included is true when an attestation from the validator was included on chain.
+	Included bool `protobuf:"varint,2,opt,name=included,proto3" json:"included,omitempty"`
+	// InclusionSlot is the slot of the first block that included the validator's attestation.
+	InclusionSlot  *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=inclusion_slot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDelay *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=inclusion_delay,proto3" json:"inclusion_delay,omitempty"`
+	CorrectTarget  bool                    `protobuf:"varint,5,opt,name=correct_target,proto3" json:"correct_target,omitempty"`
+	CorrectHead    bool                    `protobuf:"varint,6,opt,name=correct_head,proto3" json:"correct_head,omitempty"`
+}
+
+func (x *ValidatorParticipation) Reset() {
+	*x = ValidatorParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_eth_v1_committee_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorParticipation) ProtoMessage() {}
+
+func (x *ValidatorParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_eth_v1_committee_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorParticipation.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_eth_v1_committee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorParticipation) GetValidatorIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return nil
+}
+
+func (x *ValidatorParticipation) GetIncluded() bool {
+	if x != nil {
+		return x.Included
+	}
+	return false
+}
+
+func (x *ValidatorParticipation) GetInclusionSlot() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.InclusionSlot
+	}
+	return nil
+}
+
+func (x *ValidatorParticipation) GetInclusionDelay() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.InclusionDelay
+	}
+	return nil
+}
+
+func (x *ValidatorParticipation) GetCorrectTarget() bool {
+	if x != nil {
+		return x.CorrectTarget
+	}
+	return false
+}
+
+func (x *ValidatorParticipation) GetCorrectHead() bool {
+	if x != nil {
+		return x.CorrectHead
+	}
+	return false
+}
+
+// ValidatorActivity is the participation of every validator in a single committee.
+type ValidatorActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch          *wrapperspb.UInt64Value   `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Slot           *wrapperspb.UInt64Value   `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex *wrapperspb.UInt64Value   `protobuf:"bytes,3,opt,name=committee_index,proto3" json:"committee_index,omitempty"`
+	Validators     []*ValidatorParticipation `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *ValidatorActivity) Reset() {
+	*x = ValidatorActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_eth_v1_committee_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorActivity) ProtoMessage() {}
+
+func (x *ValidatorActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_eth_v1_committee_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorActivity.ProtoReflect.Descriptor instead.
+func (*ValidatorActivity) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_eth_v1_committee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorActivity) GetEpoch() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ValidatorActivity) GetSlot() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ValidatorActivity) GetCommitteeIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return nil
+}
+
+func (x *ValidatorActivity) GetValidators() []*ValidatorParticipation {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
 var File_pkg_proto_eth_v1_committee_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_eth_v1_committee_proto_rawDesc = []byte{
@@ -106,10 +268,48 @@ var file_pkg_proto_eth_v1_committee_proto_rawDesc = []byte{
 	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x65, 0x74, 0x68, 0x70, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x70, 0x73, 0x2f, 0x78, 0x61, 0x74, 0x75,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x22, 0xd6, 0x02, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x12, 0x44, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x46, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x11, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x46, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x43,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x65, 0x74, 0x68, 0x70, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x70, 0x73, 0x2f, 0x78, 0x61,
+	0x74, 0x75, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -124,20 +324,29 @@ func file_pkg_proto_eth_v1_committee_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_eth_v1_committee_proto_rawDescData
 }
 
-var file_pkg_proto_eth_v1_committee_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_eth_v1_committee_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_pkg_proto_eth_v1_committee_proto_goTypes = []interface{}{
 	(*Committee)(nil),              // 0: xatu.eth.v1.Committee
-	(*wrapperspb.UInt64Value)(nil), // 1: google.protobuf.UInt64Value
+	(*ValidatorParticipation)(nil), // 1: xatu.eth.v1.ValidatorParticipation
+	(*ValidatorActivity)(nil),      // 2: xatu.eth.v1.ValidatorActivity
+	(*wrapperspb.UInt64Value)(nil), // 3: google.protobuf.UInt64Value
 }
 var file_pkg_proto_eth_v1_committee_proto_depIdxs = []int32{
-	1, // 0: xatu.eth.v1.Committee.index:type_name -> google.protobuf.UInt64Value
-	1, // 1: xatu.eth.v1.Committee.slot:type_name -> google.protobuf.UInt64Value
-	1, // 2: xatu.eth.v1.Committee.validators:type_name -> google.protobuf.UInt64Value
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3,  // 0: xatu.eth.v1.Committee.index:type_name -> google.protobuf.UInt64Value
+	3,  // 1: xatu.eth.v1.Committee.slot:type_name -> google.protobuf.UInt64Value
+	3,  // 2: xatu.eth.v1.Committee.validators:type_name -> google.protobuf.UInt64Value
+	3,  // 3: xatu.eth.v1.ValidatorParticipation.validator_index:type_name -> google.protobuf.UInt64Value
+	3,  // 4: xatu.eth.v1.ValidatorParticipation.inclusion_slot:type_name -> google.protobuf.UInt64Value
+	3,  // 5: xatu.eth.v1.ValidatorParticipation.inclusion_delay:type_name -> google.protobuf.UInt64Value
+	3,  // 6: xatu.eth.v1.ValidatorActivity.epoch:type_name -> google.protobuf.UInt64Value
+	3,  // 7: xatu.eth.v1.ValidatorActivity.slot:type_name -> google.protobuf.UInt64Value
+	3,  // 8: xatu.eth.v1.ValidatorActivity.committee_index:type_name -> google.protobuf.UInt64Value
+	1,  // 9: xatu.eth.v1.ValidatorActivity.validators:type_name -> xatu.eth.v1.ValidatorParticipation
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_eth_v1_committee_proto_init() }
@@ -158,6 +367,30 @@ func file_pkg_proto_eth_v1_committee_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_eth_v1_committee_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_eth_v1_committee_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -165,7 +398,7 @@ func file_pkg_proto_eth_v1_committee_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_eth_v1_committee_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -33,21 +33,39 @@ const (
 	CannonType_BEACON_API_ETH_V2_BEACON_BLOCK                         CannonType = 7
 	CannonType_BLOCKPRINT_BLOCK_CLASSIFICATION                        CannonType = 8
 	CannonType_BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR                  CannonType = 9
+	CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE          CannonType = 10
+	CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY                 CannonType = 11
+	CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD                  CannonType = 12
+	CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION             CannonType = 13
+	CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD       CannonType = 14
+	CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY            CannonType = 15
+	CannonType_BEACON_API_ETH_V1_BEACON_LIGHT_CLIENT_UPDATE           CannonType = 16
+	CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY                        CannonType = 17
+	CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE                     CannonType = 18
 )
 
 // Enum value maps for CannonType.
 var (
 	CannonType_name = map[int32]string{
-		0: "BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT",
-		1: "BEACON_API_ETH_V2_BEACON_BLOCK_PROPOSER_SLASHING",
-		2: "BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT",
-		3: "BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTER_SLASHING",
-		4: "BEACON_API_ETH_V2_BEACON_BLOCK_BLS_TO_EXECUTION_CHANGE",
-		5: "BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_TRANSACTION",
-		6: "BEACON_API_ETH_V2_BEACON_BLOCK_WITHDRAWAL",
-		7: "BEACON_API_ETH_V2_BEACON_BLOCK",
-		8: "BLOCKPRINT_BLOCK_CLASSIFICATION",
-		9: "BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR",
+		0:  "BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT",
+		1:  "BEACON_API_ETH_V2_BEACON_BLOCK_PROPOSER_SLASHING",
+		2:  "BEACON_API_ETH_V2_BEACON_BLOCK_DEPOSIT",
+		3:  "BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTER_SLASHING",
+		4:  "BEACON_API_ETH_V2_BEACON_BLOCK_BLS_TO_EXECUTION_CHANGE",
+		5:  "BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_TRANSACTION",
+		6:  "BEACON_API_ETH_V2_BEACON_BLOCK_WITHDRAWAL",
+		7:  "BEACON_API_ETH_V2_BEACON_BLOCK",
+		8:  "BLOCKPRINT_BLOCK_CLASSIFICATION",
+		9:  "BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR",
+		10: "BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE",
+		11: "BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY",
+		12: "BEACON_API_ETH_V1_BEACON_BLOCK_REWARD",
+		13: "BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION",
+		14: "BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD",
+		15: "BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY",
+		16: "BEACON_API_ETH_V1_BEACON_LIGHT_CLIENT_UPDATE",
+		17: "BEACON_API_ETH_V1_PROPOSER_DUTY",
+		18: "BEACON_API_ETH_V1_BEACON_COMMITTEE",
 	}
 	CannonType_value = map[string]int32{
 		"BEACON_API_ETH_V2_BEACON_BLOCK_VOLUNTARY_EXIT":          0,
@@ -60,6 +78,15 @@ var (
 		"BEACON_API_ETH_V2_BEACON_BLOCK":                         7,
 		"BLOCKPRINT_BLOCK_CLASSIFICATION":                        8,
 		"BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR":                  9,
+		"BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE":          10,
+		"BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY":                 11,
+		"BEACON_API_ETH_V1_BEACON_BLOCK_REWARD":                  12,
+		"BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION":             13,
+		"BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD":       14,
+		"BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY":            15,
+		"BEACON_API_ETH_V1_BEACON_LIGHT_CLIENT_UPDATE":           16,
+		"BEACON_API_ETH_V1_PROPOSER_DUTY":                        17,
+		"BEACON_API_ETH_V1_BEACON_COMMITTEE":                     18,
 	}
 )
 
@@ -1242,6 +1269,382 @@ func (x *CannonLocationEthV1BeaconBlobSidecar) GetEpoch() uint64 {
 	return 0
 }
 
+type CannonLocationEthV2BeaconBlockSyncAggregate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV2BeaconBlockSyncAggregate) Reset() {
+	*x = CannonLocationEthV2BeaconBlockSyncAggregate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV2BeaconBlockSyncAggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV2BeaconBlockSyncAggregate) ProtoMessage() {}
+
+func (x *CannonLocationEthV2BeaconBlockSyncAggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV2BeaconBlockSyncAggregate.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV2BeaconBlockSyncAggregate) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CannonLocationEthV2BeaconBlockSyncAggregate) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CannonLocationEthV2BeaconBlockSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV2BeaconBlockSummary) Reset() {
+	*x = CannonLocationEthV2BeaconBlockSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV2BeaconBlockSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV2BeaconBlockSummary) ProtoMessage() {}
+
+func (x *CannonLocationEthV2BeaconBlockSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV2BeaconBlockSummary.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV2BeaconBlockSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CannonLocationEthV2BeaconBlockSummary) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CannonLocationEthV1BeaconBlockReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV1BeaconBlockReward) Reset() {
+	*x = CannonLocationEthV1BeaconBlockReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV1BeaconBlockReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV1BeaconBlockReward) ProtoMessage() {}
+
+func (x *CannonLocationEthV1BeaconBlockReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV1BeaconBlockReward.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV1BeaconBlockReward) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CannonLocationEthV1BeaconBlockReward) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CannonLocationEthV2BeaconBlockAttestation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV2BeaconBlockAttestation) Reset() {
+	*x = CannonLocationEthV2BeaconBlockAttestation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV2BeaconBlockAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV2BeaconBlockAttestation) ProtoMessage() {}
+
+func (x *CannonLocationEthV2BeaconBlockAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV2BeaconBlockAttestation.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV2BeaconBlockAttestation) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CannonLocationEthV2BeaconBlockAttestation) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CannonLocationEthV2BeaconBlockExecutionPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV2BeaconBlockExecutionPayload) Reset() {
+	*x = CannonLocationEthV2BeaconBlockExecutionPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV2BeaconBlockExecutionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV2BeaconBlockExecutionPayload) ProtoMessage() {}
+
+func (x *CannonLocationEthV2BeaconBlockExecutionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV2BeaconBlockExecutionPayload.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV2BeaconBlockExecutionPayload) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CannonLocationEthV2BeaconBlockExecutionPayload) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CannonLocationEthV1BeaconValidatorActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV1BeaconValidatorActivity) Reset() {
+	*x = CannonLocationEthV1BeaconValidatorActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV1BeaconValidatorActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV1BeaconValidatorActivity) ProtoMessage() {}
+
+func (x *CannonLocationEthV1BeaconValidatorActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV1BeaconValidatorActivity.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV1BeaconValidatorActivity) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CannonLocationEthV1BeaconValidatorActivity) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CannonLocationEthV1ProposerDuty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV1ProposerDuty) Reset() {
+	*x = CannonLocationEthV1ProposerDuty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV1ProposerDuty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV1ProposerDuty) ProtoMessage() {}
+
+func (x *CannonLocationEthV1ProposerDuty) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV1ProposerDuty.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV1ProposerDuty) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CannonLocationEthV1ProposerDuty) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CannonLocationEthV1BeaconCommittee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CannonLocationEthV1BeaconCommittee) Reset() {
+	*x = CannonLocationEthV1BeaconCommittee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CannonLocationEthV1BeaconCommittee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CannonLocationEthV1BeaconCommittee) ProtoMessage() {}
+
+func (x *CannonLocationEthV1BeaconCommittee) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CannonLocationEthV1BeaconCommittee.ProtoReflect.Descriptor instead.
+func (*CannonLocationEthV1BeaconCommittee) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CannonLocationEthV1BeaconCommittee) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
 type CannonLocation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1261,13 +1664,21 @@ type CannonLocation struct {
 	//	*CannonLocation_EthV2BeaconBlock
 	//	*CannonLocation_BlockprintBlockClassification
 	//	*CannonLocation_EthV1BeaconBlobSidecar
+	//	*CannonLocation_EthV2BeaconBlockSyncAggregate
+	//	*CannonLocation_EthV2BeaconBlockSummary
+	//	*CannonLocation_EthV1BeaconBlockReward
+	//	*CannonLocation_EthV2BeaconBlockAttestation
+	//	*CannonLocation_EthV2BeaconBlockExecutionPayload
+	//	*CannonLocation_EthV1BeaconValidatorActivity
+	//	*CannonLocation_EthV1ProposerDuty
+	//	*CannonLocation_EthV1BeaconCommittee
 	Data isCannonLocation_Data `protobuf_oneof:"Data"`
 }
 
 func (x *CannonLocation) Reset() {
 	*x = CannonLocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[22]
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1280,7 +1691,7 @@ func (x *CannonLocation) String() string {
 func (*CannonLocation) ProtoMessage() {}
 
 func (x *CannonLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[22]
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1704,7 @@ func (x *CannonLocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CannonLocation.ProtoReflect.Descriptor instead.
 func (*CannonLocation) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{22}
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CannonLocation) GetNetworkId() string {
@@ -1387,6 +1798,62 @@ func (x *CannonLocation) GetEthV1BeaconBlobSidecar() *CannonLocationEthV1BeaconB
 	return nil
 }
 
+func (x *CannonLocation) GetEthV2BeaconBlockSyncAggregate() *CannonLocationEthV2BeaconBlockSyncAggregate {
+	if x, ok := x.GetData().(*CannonLocation_EthV2BeaconBlockSyncAggregate); ok {
+		return x.EthV2BeaconBlockSyncAggregate
+	}
+	return nil
+}
+
+func (x *CannonLocation) GetEthV2BeaconBlockSummary() *CannonLocationEthV2BeaconBlockSummary {
+	if x, ok := x.GetData().(*CannonLocation_EthV2BeaconBlockSummary); ok {
+		return x.EthV2BeaconBlockSummary
+	}
+	return nil
+}
+
+func (x *CannonLocation) GetEthV1BeaconBlockReward() *CannonLocationEthV1BeaconBlockReward {
+	if x, ok := x.GetData().(*CannonLocation_EthV1BeaconBlockReward); ok {
+		return x.EthV1BeaconBlockReward
+	}
+	return nil
+}
+
+func (x *CannonLocation) GetEthV2BeaconBlockAttestation() *CannonLocationEthV2BeaconBlockAttestation {
+	if x, ok := x.GetData().(*CannonLocation_EthV2BeaconBlockAttestation); ok {
+		return x.EthV2BeaconBlockAttestation
+	}
+	return nil
+}
+
+func (x *CannonLocation) GetEthV2BeaconBlockExecutionPayload() *CannonLocationEthV2BeaconBlockExecutionPayload {
+	if x, ok := x.GetData().(*CannonLocation_EthV2BeaconBlockExecutionPayload); ok {
+		return x.EthV2BeaconBlockExecutionPayload
+	}
+	return nil
+}
+
+func (x *CannonLocation) GetEthV1BeaconValidatorActivity() *CannonLocationEthV1BeaconValidatorActivity {
+	if x, ok := x.GetData().(*CannonLocation_EthV1BeaconValidatorActivity); ok {
+		return x.EthV1BeaconValidatorActivity
+	}
+	return nil
+}
+
+func (x *CannonLocation) GetEthV1ProposerDuty() *CannonLocationEthV1ProposerDuty {
+	if x, ok := x.GetData().(*CannonLocation_EthV1ProposerDuty); ok {
+		return x.EthV1ProposerDuty
+	}
+	return nil
+}
+
+func (x *CannonLocation) GetEthV1BeaconCommittee() *CannonLocationEthV1BeaconCommittee {
+	if x, ok := x.GetData().(*CannonLocation_EthV1BeaconCommittee); ok {
+		return x.EthV1BeaconCommittee
+	}
+	return nil
+}
+
 type isCannonLocation_Data interface {
 	isCannonLocation_Data()
 }
@@ -1431,6 +1898,38 @@ type CannonLocation_EthV1BeaconBlobSidecar struct {
 	EthV1BeaconBlobSidecar *CannonLocationEthV1BeaconBlobSidecar `protobuf:"bytes,12,opt,name=eth_v1_beacon_blob_sidecar,json=BEACON_API_ETH_V1_BEACON_BLOB_SIDECAR,proto3,oneof"`
 }
 
+type CannonLocation_EthV2BeaconBlockSyncAggregate struct {
+	EthV2BeaconBlockSyncAggregate *CannonLocationEthV2BeaconBlockSyncAggregate `protobuf:"bytes,13,opt,name=eth_v2_beacon_block_sync_aggregate,json=BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE,proto3,oneof"`
+}
+
+type CannonLocation_EthV2BeaconBlockSummary struct {
+	EthV2BeaconBlockSummary *CannonLocationEthV2BeaconBlockSummary `protobuf:"bytes,14,opt,name=eth_v2_beacon_block_summary,json=BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY,proto3,oneof"`
+}
+
+type CannonLocation_EthV1BeaconBlockReward struct {
+	EthV1BeaconBlockReward *CannonLocationEthV1BeaconBlockReward `protobuf:"bytes,15,opt,name=eth_v1_beacon_block_reward,json=BEACON_API_ETH_V1_BEACON_BLOCK_REWARD,proto3,oneof"`
+}
+
+type CannonLocation_EthV2BeaconBlockAttestation struct {
+	EthV2BeaconBlockAttestation *CannonLocationEthV2BeaconBlockAttestation `protobuf:"bytes,16,opt,name=eth_v2_beacon_block_attestation,json=BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION,proto3,oneof"`
+}
+
+type CannonLocation_EthV2BeaconBlockExecutionPayload struct {
+	EthV2BeaconBlockExecutionPayload *CannonLocationEthV2BeaconBlockExecutionPayload `protobuf:"bytes,17,opt,name=eth_v2_beacon_block_execution_payload,json=BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD,proto3,oneof"`
+}
+
+type CannonLocation_EthV1BeaconValidatorActivity struct {
+	EthV1BeaconValidatorActivity *CannonLocationEthV1BeaconValidatorActivity `protobuf:"bytes,18,opt,name=eth_v1_beacon_validator_activity,json=BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY,proto3,oneof"`
+}
+
+type CannonLocation_EthV1ProposerDuty struct {
+	EthV1ProposerDuty *CannonLocationEthV1ProposerDuty `protobuf:"bytes,19,opt,name=eth_v1_proposer_duty,json=BEACON_API_ETH_V1_PROPOSER_DUTY,proto3,oneof"`
+}
+
+type CannonLocation_EthV1BeaconCommittee struct {
+	EthV1BeaconCommittee *CannonLocationEthV1BeaconCommittee `protobuf:"bytes,20,opt,name=eth_v1_beacon_committee,json=BEACON_API_ETH_V1_BEACON_COMMITTEE,proto3,oneof"`
+}
+
 func (*CannonLocation_EthV2BeaconBlockVoluntaryExit) isCannonLocation_Data() {}
 
 func (*CannonLocation_EthV2BeaconBlockProposerSlashing) isCannonLocation_Data() {}
@@ -1451,6 +1950,22 @@ func (*CannonLocation_BlockprintBlockClassification) isCannonLocation_Data() {}
 
 func (*CannonLocation_EthV1BeaconBlobSidecar) isCannonLocation_Data() {}
 
+func (*CannonLocation_EthV2BeaconBlockSyncAggregate) isCannonLocation_Data() {}
+
+func (*CannonLocation_EthV2BeaconBlockSummary) isCannonLocation_Data() {}
+
+func (*CannonLocation_EthV1BeaconBlockReward) isCannonLocation_Data() {}
+
+func (*CannonLocation_EthV2BeaconBlockAttestation) isCannonLocation_Data() {}
+
+func (*CannonLocation_EthV2BeaconBlockExecutionPayload) isCannonLocation_Data() {}
+
+func (*CannonLocation_EthV1BeaconValidatorActivity) isCannonLocation_Data() {}
+
+func (*CannonLocation_EthV1ProposerDuty) isCannonLocation_Data() {}
+
+func (*CannonLocation_EthV1BeaconCommittee) isCannonLocation_Data() {}
+
 type GetCannonLocationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1463,7 +1978,7 @@ type GetCannonLocationRequest struct {
 func (x *GetCannonLocationRequest) Reset() {
 	*x = GetCannonLocationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[23]
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1476,7 +1991,7 @@ func (x *GetCannonLocationRequest) String() string {
 func (*GetCannonLocationRequest) ProtoMessage() {}
 
 func (x *GetCannonLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[23]
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1489,7 +2004,7 @@ func (x *GetCannonLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCannonLocationRequest.ProtoReflect.Descriptor instead.
 func (*GetCannonLocationRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{23}
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetCannonLocationRequest) GetNetworkId() string {
@@ -1517,7 +2032,7 @@ type GetCannonLocationResponse struct {
 func (x *GetCannonLocationResponse) Reset() {
 	*x = GetCannonLocationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[24]
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1530,7 +2045,7 @@ func (x *GetCannonLocationResponse) String() string {
 func (*GetCannonLocationResponse) ProtoMessage() {}
 
 func (x *GetCannonLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[24]
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,7 +2058,7 @@ func (x *GetCannonLocationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCannonLocationResponse.ProtoReflect.Descriptor instead.
 func (*GetCannonLocationResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{24}
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetCannonLocationResponse) GetLocation() *CannonLocation {
@@ -1559,12 +2074,14 @@ type UpsertCannonLocationRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Location *CannonLocation `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// Confirm asks the coordinator to read the location back after storing it and return it in the response.
+	Confirm bool `protobuf:"varint,2,opt,name=confirm,proto3" json:"confirm,omitempty"`
 }
 
 func (x *UpsertCannonLocationRequest) Reset() {
 	*x = UpsertCannonLocationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[25]
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1577,7 +2094,7 @@ func (x *UpsertCannonLocationRequest) String() string {
 func (*UpsertCannonLocationRequest) ProtoMessage() {}
 
 func (x *UpsertCannonLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[25]
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +2107,7 @@ func (x *UpsertCannonLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertCannonLocationRequest.ProtoReflect.Descriptor instead.
 func (*UpsertCannonLocationRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{25}
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *UpsertCannonLocationRequest) GetLocation() *CannonLocation {
@@ -1600,16 +2117,26 @@ func (x *UpsertCannonLocationRequest) GetLocation() *CannonLocation {
 	return nil
 }
 
+func (x *UpsertCannonLocationRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
 type UpsertCannonLocationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Location is the location stored by the coordinator. Only set when confirm was requested.
+	Location *CannonLocation `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 }
 
 func (x *UpsertCannonLocationResponse) Reset() {
 	*x = UpsertCannonLocationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[26]
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +2149,7 @@ func (x *UpsertCannonLocationResponse) String() string {
 func (*UpsertCannonLocationResponse) ProtoMessage() {}
 
 func (x *UpsertCannonLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[26]
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +2162,14 @@ func (x *UpsertCannonLocationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertCannonLocationResponse.ProtoReflect.Descriptor instead.
 func (*UpsertCannonLocationResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{26}
+	return file_pkg_proto_xatu_coordinator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpsertCannonLocationResponse) GetLocation() *CannonLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
 }
 
 type ExecutionNodeStatus_Capability struct {
@@ -1650,7 +2184,7 @@ type ExecutionNodeStatus_Capability struct {
 func (x *ExecutionNodeStatus_Capability) Reset() {
 	*x = ExecutionNodeStatus_Capability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[27]
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1663,7 +2197,7 @@ func (x *ExecutionNodeStatus_Capability) String() string {
 func (*ExecutionNodeStatus_Capability) ProtoMessage() {}
 
 func (x *ExecutionNodeStatus_Capability) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[27]
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,7 +2239,7 @@ type ExecutionNodeStatus_ForkID struct {
 func (x *ExecutionNodeStatus_ForkID) Reset() {
 	*x = ExecutionNodeStatus_ForkID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[28]
+		mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1718,7 +2252,7 @@ func (x *ExecutionNodeStatus_ForkID) String() string {
 func (*ExecutionNodeStatus_ForkID) ProtoMessage() {}
 
 func (x *ExecutionNodeStatus_ForkID) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[28]
+	mi := &file_pkg_proto_xatu_coordinator_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1892,119 +2426,221 @@ var file_pkg_proto_xatu_coordinator_proto_rawDesc = []byte{
 	0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74,
 	0x68, 0x56, 0x31, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64,
 	0x65, 0x63, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd1, 0x0b, 0x0a, 0x0e, 0x43,
-	0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x78, 0x61, 0x74,
-	0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x22, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6e,
-	0x74, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x43, 0x0a, 0x2b, 0x43, 0x61,
+	0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56,
+	0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22,
+	0x3d, 0x0a, 0x25, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x3c,
+	0x0a, 0x24, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x74, 0x68, 0x56, 0x31, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x41, 0x0a, 0x29,
+	0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74,
+	0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22,
+	0x46, 0x0a, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x42, 0x0a, 0x2a, 0x43, 0x61, 0x6e, 0x6e, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x31, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x37, 0x0a, 0x1f, 0x43,
+	0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68,
+	0x56, 0x31, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x44, 0x75, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0x3a, 0x0a, 0x22, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x31, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x22, 0xdf, 0x13, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x22, 0x65, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e,
+	0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x45, 0x78, 0x69, 0x74, 0x48, 0x00, 0x52, 0x2d, 0x42, 0x45, 0x41, 0x43,
+	0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45,
+	0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4e,
+	0x54, 0x41, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x12, 0x97, 0x01, 0x0a, 0x25, 0x65, 0x74,
+	0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x78, 0x61, 0x74, 0x75,
+	0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x48,
+	0x00, 0x52, 0x30, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54,
+	0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x4c, 0x41, 0x53, 0x48,
+	0x49, 0x4e, 0x47, 0x12, 0x7a, 0x0a, 0x1b, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e,
+	0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74,
+	0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x26, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
+	0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f,
+	0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x12,
+	0x97, 0x01, 0x0a, 0x25, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x45, 0x78,
-	0x69, 0x74, 0x48, 0x00, 0x52, 0x2d, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49,
-	0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42,
-	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4e, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x45,
-	0x58, 0x49, 0x54, 0x12, 0x97, 0x01, 0x0a, 0x25, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f,
-	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x30, 0x42, 0x45, 0x41,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x30, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
+	0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f,
+	0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x12, 0xa7, 0x01, 0x0a, 0x2b, 0x65, 0x74,
+	0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x62, 0x6c, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6c, 0x73, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x36, 0x42, 0x45, 0x41,
 	0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42,
-	0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x50,
-	0x4f, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x12, 0x7a, 0x0a,
+	0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x4c, 0x53, 0x5f,
+	0x54, 0x4f, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x12, 0xa3, 0x01, 0x0a, 0x29, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43,
+	0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68,
+	0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x34, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f,
+	0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x83, 0x01, 0x0a, 0x1e, 0x65, 0x74,
+	0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x48, 0x00, 0x52, 0x29, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x41, 0x4c, 0x12,
+	0x63, 0x0a, 0x13, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78,
+	0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x00, 0x52, 0x1e, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x12, 0x7d, 0x0a, 0x1f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x1f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x50, 0x52, 0x49, 0x4e, 0x54, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x12, 0x77, 0x0a, 0x1a, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x31, 0x5f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43,
+	0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68,
+	0x56, 0x31, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x48, 0x00, 0x52, 0x25, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50,
+	0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
+	0x42, 0x4c, 0x4f, 0x42, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x12, 0x8e, 0x01, 0x0a,
+	0x22, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x78, 0x61, 0x74, 0x75,
+	0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x79, 0x6e, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x2d,
+	0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56,
+	0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x12, 0x7a, 0x0a,
 	0x1b, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e,
 	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48,
+	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48,
 	0x00, 0x52, 0x26, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54,
 	0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x12, 0x97, 0x01, 0x0a, 0x25, 0x65, 0x74,
-	0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68,
-	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x78, 0x61, 0x74, 0x75,
-	0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x48,
-	0x00, 0x52, 0x30, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54,
-	0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x4c, 0x41, 0x53, 0x48,
-	0x49, 0x4e, 0x47, 0x12, 0xa7, 0x01, 0x0a, 0x2b, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x6c, 0x73, 0x5f,
-	0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x78, 0x61, 0x74, 0x75,
-	0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
-	0x6c, 0x73, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x36, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50,
-	0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
-	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x4c, 0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x45, 0x58, 0x45,
-	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x12, 0xa3, 0x01,
-	0x0a, 0x29, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x38, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x34, 0x42,
-	0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32,
-	0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58,
-	0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x12, 0x83, 0x01, 0x0a, 0x1e, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x78,
+	0x4b, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x12, 0x77, 0x0a, 0x1a, 0x65, 0x74, 0x68,
+	0x5f, 0x76, 0x31, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x31, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x25, 0x42, 0x45, 0x41,
+	0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42,
+	0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x57, 0x41,
+	0x52, 0x44, 0x12, 0x86, 0x01, 0x0a, 0x1f, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x78,
 	0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x29,
-	0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56,
-	0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x41, 0x4c, 0x12, 0x63, 0x0a, 0x13, 0x65, 0x74, 0x68,
-	0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61,
-	0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56,
-	0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x1e,
-	0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56,
-	0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x12, 0x7d,
-	0x0a, 0x1f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43,
-	0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x1f, 0x42, 0x4c,
-	0x4f, 0x43, 0x4b, 0x50, 0x52, 0x49, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43,
-	0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x77, 0x0a,
-	0x1a, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x31, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62,
-	0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x31, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x48, 0x00, 0x52,
-	0x25, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f,
-	0x56, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x42, 0x5f, 0x53,
-	0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x42, 0x06, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x5f,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43,
-	0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x4d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f,
-	0x0a, 0x1b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x63, 0x6b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x2a, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f,
+	0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x97, 0x01, 0x0a, 0x25,
+	0x65, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x78, 0x61,
+	0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x74, 0x68, 0x56, 0x32, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x48, 0x00, 0x52, 0x30, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f,
+	0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41,
+	0x59, 0x4c, 0x4f, 0x41, 0x44, 0x12, 0x89, 0x01, 0x0a, 0x20, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x31,
+	0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x31, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x2b, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
+	0x59, 0x12, 0x66, 0x0a, 0x14, 0x65, 0x74, 0x68, 0x5f, 0x76, 0x31, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x74, 0x68, 0x56, 0x31, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x44, 0x75, 0x74, 0x79, 0x48, 0x00, 0x52, 0x1f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e,
+	0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x50, 0x52, 0x4f, 0x50,
+	0x4f, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x55, 0x54, 0x59, 0x12, 0x6f, 0x0a, 0x17, 0x65, 0x74, 0x68,
+	0x5f, 0x76, 0x31, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78, 0x61, 0x74,
+	0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x74, 0x68, 0x56, 0x31, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x48, 0x00, 0x52, 0x22, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41,
+	0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x45, 0x42, 0x06, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x5f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x78, 0x61,
+	0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x1b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x6e,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x22, 0x50, 0x0a,
+	0x1c, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
 	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
-	0xf0, 0x03, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a,
+	0x90, 0x07, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x6e, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31,
 	0x0a, 0x2d, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48,
 	0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
 	0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4e, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10,
@@ -2035,7 +2671,33 @@ var file_pkg_proto_xatu_coordinator_proto_rawDesc = []byte{
 	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x29, 0x0a, 0x25, 0x42, 0x45, 0x41, 0x43, 0x4f,
 	0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42, 0x45, 0x41,
 	0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x42, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52,
-	0x10, 0x09, 0x32, 0x8a, 0x06, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x10, 0x09, 0x12, 0x31, 0x0a, 0x2d, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x45, 0x10, 0x0a, 0x12, 0x2a, 0x0a, 0x26, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
+	0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f,
+	0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x10,
+	0x0b, 0x12, 0x29, 0x0a, 0x25, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f,
+	0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x10, 0x0c, 0x12, 0x2e, 0x0a, 0x2a,
+	0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56,
+	0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41,
+	0x54, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x34, 0x0a, 0x30,
+	0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56,
+	0x32, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45,
+	0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44,
+	0x10, 0x0e, 0x12, 0x2f, 0x0a, 0x2b, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
+	0x59, 0x10, 0x0f, 0x12, 0x30, 0x0a, 0x2c, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50,
+	0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
+	0x4c, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x10, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
+	0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f,
+	0x53, 0x45, 0x52, 0x5f, 0x44, 0x55, 0x54, 0x59, 0x10, 0x11, 0x12, 0x26, 0x0a, 0x22, 0x42, 0x45,
+	0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x54, 0x48, 0x5f, 0x56, 0x31, 0x5f,
+	0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x45,
+	0x10, 0x12, 0x32, 0x8a, 0x06, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
 	0x6f, 0x72, 0x12, 0x56, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
 	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x78, 0x61, 0x74, 0x75, 0x2e, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
@@ -2103,7 +2765,7 @@ func file_pkg_proto_xatu_coordinator_proto_rawDescGZIP() []byte {
 }
 
 var file_pkg_proto_xatu_coordinator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_proto_xatu_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_pkg_proto_xatu_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_pkg_proto_xatu_coordinator_proto_goTypes = []interface{}{
 	(CannonType)(0),                                            // 0: xatu.CannonType
 	(*CreateNodeRecordsRequest)(nil),                           // 1: xatu.CreateNodeRecordsRequest
@@ -2128,17 +2790,25 @@ var file_pkg_proto_xatu_coordinator_proto_goTypes = []interface{}{
 	(*CannonLocationEthV2BeaconBlock)(nil),                     // 20: xatu.CannonLocationEthV2BeaconBlock
 	(*CannonLocationBlockprintBlockClassification)(nil),        // 21: xatu.CannonLocationBlockprintBlockClassification
 	(*CannonLocationEthV1BeaconBlobSidecar)(nil),               // 22: xatu.CannonLocationEthV1BeaconBlobSidecar
-	(*CannonLocation)(nil),                                     // 23: xatu.CannonLocation
-	(*GetCannonLocationRequest)(nil),                           // 24: xatu.GetCannonLocationRequest
-	(*GetCannonLocationResponse)(nil),                          // 25: xatu.GetCannonLocationResponse
-	(*UpsertCannonLocationRequest)(nil),                        // 26: xatu.UpsertCannonLocationRequest
-	(*UpsertCannonLocationResponse)(nil),                       // 27: xatu.UpsertCannonLocationResponse
-	(*ExecutionNodeStatus_Capability)(nil),                     // 28: xatu.ExecutionNodeStatus.Capability
-	(*ExecutionNodeStatus_ForkID)(nil),                         // 29: xatu.ExecutionNodeStatus.ForkID
+	(*CannonLocationEthV2BeaconBlockSyncAggregate)(nil),        // 23: xatu.CannonLocationEthV2BeaconBlockSyncAggregate
+	(*CannonLocationEthV2BeaconBlockSummary)(nil),              // 24: xatu.CannonLocationEthV2BeaconBlockSummary
+	(*CannonLocationEthV1BeaconBlockReward)(nil),               // 25: xatu.CannonLocationEthV1BeaconBlockReward
+	(*CannonLocationEthV2BeaconBlockAttestation)(nil),          // 26: xatu.CannonLocationEthV2BeaconBlockAttestation
+	(*CannonLocationEthV2BeaconBlockExecutionPayload)(nil),     // 27: xatu.CannonLocationEthV2BeaconBlockExecutionPayload
+	(*CannonLocationEthV1BeaconValidatorActivity)(nil),         // 28: xatu.CannonLocationEthV1BeaconValidatorActivity
+	(*CannonLocationEthV1ProposerDuty)(nil),                    // 29: xatu.CannonLocationEthV1ProposerDuty
+	(*CannonLocationEthV1BeaconCommittee)(nil),                 // 30: xatu.CannonLocationEthV1BeaconCommittee
+	(*CannonLocation)(nil),                                     // 31: xatu.CannonLocation
+	(*GetCannonLocationRequest)(nil),                           // 32: xatu.GetCannonLocationRequest
+	(*GetCannonLocationResponse)(nil),                          // 33: xatu.GetCannonLocationResponse
+	(*UpsertCannonLocationRequest)(nil),                        // 34: xatu.UpsertCannonLocationRequest
+	(*UpsertCannonLocationResponse)(nil),                       // 35: xatu.UpsertCannonLocationResponse
+	(*ExecutionNodeStatus_Capability)(nil),                     // 36: xatu.ExecutionNodeStatus.Capability
+	(*ExecutionNodeStatus_ForkID)(nil),                         // 37: xatu.ExecutionNodeStatus.ForkID
 }
 var file_pkg_proto_xatu_coordinator_proto_depIdxs = []int32{
-	28, // 0: xatu.ExecutionNodeStatus.capabilities:type_name -> xatu.ExecutionNodeStatus.Capability
-	29, // 1: xatu.ExecutionNodeStatus.fork_id:type_name -> xatu.ExecutionNodeStatus.ForkID
+	36, // 0: xatu.ExecutionNodeStatus.capabilities:type_name -> xatu.ExecutionNodeStatus.Capability
+	37, // 1: xatu.ExecutionNodeStatus.fork_id:type_name -> xatu.ExecutionNodeStatus.ForkID
 	5,  // 2: xatu.CreateExecutionNodeRecordStatusRequest.status:type_name -> xatu.ExecutionNodeStatus
 	8,  // 3: xatu.CoordinateExecutionNodeRecordsRequest.node_records:type_name -> xatu.CoordinatedNodeRecord
 	0,  // 4: xatu.CannonLocation.type:type_name -> xatu.CannonType
@@ -2152,28 +2822,37 @@ var file_pkg_proto_xatu_coordinator_proto_depIdxs = []int32{
 	20, // 12: xatu.CannonLocation.eth_v2_beacon_block:type_name -> xatu.CannonLocationEthV2BeaconBlock
 	21, // 13: xatu.CannonLocation.blockprint_block_classification:type_name -> xatu.CannonLocationBlockprintBlockClassification
 	22, // 14: xatu.CannonLocation.eth_v1_beacon_blob_sidecar:type_name -> xatu.CannonLocationEthV1BeaconBlobSidecar
-	0,  // 15: xatu.GetCannonLocationRequest.type:type_name -> xatu.CannonType
-	23, // 16: xatu.GetCannonLocationResponse.location:type_name -> xatu.CannonLocation
-	23, // 17: xatu.UpsertCannonLocationRequest.location:type_name -> xatu.CannonLocation
-	1,  // 18: xatu.Coordinator.CreateNodeRecords:input_type -> xatu.CreateNodeRecordsRequest
-	3,  // 19: xatu.Coordinator.ListStalledExecutionNodeRecords:input_type -> xatu.ListStalledExecutionNodeRecordsRequest
-	6,  // 20: xatu.Coordinator.CreateExecutionNodeRecordStatus:input_type -> xatu.CreateExecutionNodeRecordStatusRequest
-	9,  // 21: xatu.Coordinator.CoordinateExecutionNodeRecords:input_type -> xatu.CoordinateExecutionNodeRecordsRequest
-	11, // 22: xatu.Coordinator.GetDiscoveryNodeRecord:input_type -> xatu.GetDiscoveryNodeRecordRequest
-	24, // 23: xatu.Coordinator.GetCannonLocation:input_type -> xatu.GetCannonLocationRequest
-	26, // 24: xatu.Coordinator.UpsertCannonLocation:input_type -> xatu.UpsertCannonLocationRequest
-	2,  // 25: xatu.Coordinator.CreateNodeRecords:output_type -> xatu.CreateNodeRecordsResponse
-	4,  // 26: xatu.Coordinator.ListStalledExecutionNodeRecords:output_type -> xatu.ListStalledExecutionNodeRecordsResponse
-	7,  // 27: xatu.Coordinator.CreateExecutionNodeRecordStatus:output_type -> xatu.CreateExecutionNodeRecordStatusResponse
-	10, // 28: xatu.Coordinator.CoordinateExecutionNodeRecords:output_type -> xatu.CoordinateExecutionNodeRecordsResponse
-	12, // 29: xatu.Coordinator.GetDiscoveryNodeRecord:output_type -> xatu.GetDiscoveryNodeRecordResponse
-	25, // 30: xatu.Coordinator.GetCannonLocation:output_type -> xatu.GetCannonLocationResponse
-	27, // 31: xatu.Coordinator.UpsertCannonLocation:output_type -> xatu.UpsertCannonLocationResponse
-	25, // [25:32] is the sub-list for method output_type
-	18, // [18:25] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	23, // 15: xatu.CannonLocation.eth_v2_beacon_block_sync_aggregate:type_name -> xatu.CannonLocationEthV2BeaconBlockSyncAggregate
+	24, // 16: xatu.CannonLocation.eth_v2_beacon_block_summary:type_name -> xatu.CannonLocationEthV2BeaconBlockSummary
+	25, // 17: xatu.CannonLocation.eth_v1_beacon_block_reward:type_name -> xatu.CannonLocationEthV1BeaconBlockReward
+	26, // 18: xatu.CannonLocation.eth_v2_beacon_block_attestation:type_name -> xatu.CannonLocationEthV2BeaconBlockAttestation
+	27, // 19: xatu.CannonLocation.eth_v2_beacon_block_execution_payload:type_name -> xatu.CannonLocationEthV2BeaconBlockExecutionPayload
+	28, // 20: xatu.CannonLocation.eth_v1_beacon_validator_activity:type_name -> xatu.CannonLocationEthV1BeaconValidatorActivity
+	29, // 21: xatu.CannonLocation.eth_v1_proposer_duty:type_name -> xatu.CannonLocationEthV1ProposerDuty
+	30, // 22: xatu.CannonLocation.eth_v1_beacon_committee:type_name -> xatu.CannonLocationEthV1BeaconCommittee
+	0,  // 23: xatu.GetCannonLocationRequest.type:type_name -> xatu.CannonType
+	31, // 24: xatu.GetCannonLocationResponse.location:type_name -> xatu.CannonLocation
+	31, // 25: xatu.UpsertCannonLocationRequest.location:type_name -> xatu.CannonLocation
+	31, // 26: xatu.UpsertCannonLocationResponse.location:type_name -> xatu.CannonLocation
+	1,  // 27: xatu.Coordinator.CreateNodeRecords:input_type -> xatu.CreateNodeRecordsRequest
+	3,  // 28: xatu.Coordinator.ListStalledExecutionNodeRecords:input_type -> xatu.ListStalledExecutionNodeRecordsRequest
+	6,  // 29: xatu.Coordinator.CreateExecutionNodeRecordStatus:input_type -> xatu.CreateExecutionNodeRecordStatusRequest
+	9,  // 30: xatu.Coordinator.CoordinateExecutionNodeRecords:input_type -> xatu.CoordinateExecutionNodeRecordsRequest
+	11, // 31: xatu.Coordinator.GetDiscoveryNodeRecord:input_type -> xatu.GetDiscoveryNodeRecordRequest
+	32, // 32: xatu.Coordinator.GetCannonLocation:input_type -> xatu.GetCannonLocationRequest
+	34, // 33: xatu.Coordinator.UpsertCannonLocation:input_type -> xatu.UpsertCannonLocationRequest
+	2,  // 34: xatu.Coordinator.CreateNodeRecords:output_type -> xatu.CreateNodeRecordsResponse
+	4,  // 35: xatu.Coordinator.ListStalledExecutionNodeRecords:output_type -> xatu.ListStalledExecutionNodeRecordsResponse
+	7,  // 36: xatu.Coordinator.CreateExecutionNodeRecordStatus:output_type -> xatu.CreateExecutionNodeRecordStatusResponse
+	10, // 37: xatu.Coordinator.CoordinateExecutionNodeRecords:output_type -> xatu.CoordinateExecutionNodeRecordsResponse
+	12, // 38: xatu.Coordinator.GetDiscoveryNodeRecord:output_type -> xatu.GetDiscoveryNodeRecordResponse
+	33, // 39: xatu.Coordinator.GetCannonLocation:output_type -> xatu.GetCannonLocationResponse
+	35, // 40: xatu.Coordinator.UpsertCannonLocation:output_type -> xatu.UpsertCannonLocationResponse
+	34, // [34:41] is the sub-list for method output_type
+	27, // [27:34] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_xatu_coordinator_proto_init() }
@@ -2447,7 +3126,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 		file_pkg_proto_xatu_coordinator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CannonLocation); i {
+			switch v := v.(*CannonLocationEthV2BeaconBlockSyncAggregate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2459,7 +3138,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 		file_pkg_proto_xatu_coordinator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCannonLocationRequest); i {
+			switch v := v.(*CannonLocationEthV2BeaconBlockSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2471,7 +3150,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 		file_pkg_proto_xatu_coordinator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCannonLocationResponse); i {
+			switch v := v.(*CannonLocationEthV1BeaconBlockReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2483,7 +3162,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 		file_pkg_proto_xatu_coordinator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertCannonLocationRequest); i {
+			switch v := v.(*CannonLocationEthV2BeaconBlockAttestation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2495,7 +3174,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 		file_pkg_proto_xatu_coordinator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertCannonLocationResponse); i {
+			switch v := v.(*CannonLocationEthV2BeaconBlockExecutionPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2507,7 +3186,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 		file_pkg_proto_xatu_coordinator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionNodeStatus_Capability); i {
+			switch v := v.(*CannonLocationEthV1BeaconValidatorActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2519,6 +3198,102 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 		file_pkg_proto_xatu_coordinator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CannonLocationEthV1ProposerDuty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CannonLocationEthV1BeaconCommittee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CannonLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCannonLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCannonLocationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertCannonLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertCannonLocationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionNodeStatus_Capability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_xatu_coordinator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecutionNodeStatus_ForkID); i {
 			case 0:
 				return &v.state
@@ -2531,7 +3306,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			}
 		}
 	}
-	file_pkg_proto_xatu_coordinator_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_pkg_proto_xatu_coordinator_proto_msgTypes[30].OneofWrappers = []interface{}{
 		(*CannonLocation_EthV2BeaconBlockVoluntaryExit)(nil),
 		(*CannonLocation_EthV2BeaconBlockProposerSlashing)(nil),
 		(*CannonLocation_EthV2BeaconBlockDeposit)(nil),
@@ -2542,6 +3317,14 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 		(*CannonLocation_EthV2BeaconBlock)(nil),
 		(*CannonLocation_BlockprintBlockClassification)(nil),
 		(*CannonLocation_EthV1BeaconBlobSidecar)(nil),
+		(*CannonLocation_EthV2BeaconBlockSyncAggregate)(nil),
+		(*CannonLocation_EthV2BeaconBlockSummary)(nil),
+		(*CannonLocation_EthV1BeaconBlockReward)(nil),
+		(*CannonLocation_EthV2BeaconBlockAttestation)(nil),
+		(*CannonLocation_EthV2BeaconBlockExecutionPayload)(nil),
+		(*CannonLocation_EthV1BeaconValidatorActivity)(nil),
+		(*CannonLocation_EthV1ProposerDuty)(nil),
+		(*CannonLocation_EthV1BeaconCommittee)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2549,7 +3332,7 @@ func file_pkg_proto_xatu_coordinator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_xatu_coordinator_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   29,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,98 @@
+package xatu
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EventSlot returns the slot an event relates to, found in its additional data either directly or through the
+// block identifier.
+func EventSlot(event *DecoratedEvent) (uint64, bool) {
+	additional, ok := additionalData(event)
+	if !ok {
+		return 0, false
+	}
+
+	if slot, ok := slotNumber(additional, "slot"); ok {
+		return slot, true
+	}
+
+	if block, ok := messageField(additional, "block"); ok {
+		return slotNumber(block, "slot")
+	}
+
+	return 0, false
+}
+
+// EventBlockRoot returns the root of the block an event relates to, if its additional data carries one.
+func EventBlockRoot(event *DecoratedEvent) (string, bool) {
+	additional, ok := additionalData(event)
+	if !ok {
+		return "", false
+	}
+
+	if block, ok := messageField(additional, "block"); ok {
+		if root, ok := stringField(block, "root"); ok {
+			return root, true
+		}
+	}
+
+	return stringField(additional, "block_root")
+}
+
+func additionalData(event *DecoratedEvent) (protoreflect.Message, bool) {
+	client := event.GetMeta().GetClient()
+	if client == nil {
+		return nil, false
+	}
+
+	msg := client.ProtoReflect()
+
+	oneof := msg.Descriptor().Oneofs().ByName("AdditionalData")
+	if oneof == nil {
+		return nil, false
+	}
+
+	field := msg.WhichOneof(oneof)
+	if field == nil || field.Message() == nil {
+		return nil, false
+	}
+
+	return msg.Get(field).Message(), true
+}
+
+func messageField(msg protoreflect.Message, name protoreflect.Name) (protoreflect.Message, bool) {
+	f := msg.Descriptor().Fields().ByName(name)
+	if f == nil || f.Message() == nil || !msg.Has(f) {
+		return nil, false
+	}
+
+	return msg.Get(f).Message(), true
+}
+
+func stringField(msg protoreflect.Message, name protoreflect.Name) (string, bool) {
+	f := msg.Descriptor().Fields().ByName(name)
+	if f == nil || f.Kind() != protoreflect.StringKind || !msg.Has(f) {
+		return "", false
+	}
+
+	return msg.Get(f).String(), true
+}
+
+func slotNumber(msg protoreflect.Message, name protoreflect.Name) (uint64, bool) {
+	slot, ok := messageField(msg, name)
+	if !ok {
+		return 0, false
+	}
+
+	wrapper, ok := messageField(slot, "number")
+	if !ok {
+		return 0, false
+	}
+
+	value := wrapper.Descriptor().Fields().ByName("value")
+	if value == nil || value.Kind() != protoreflect.Uint64Kind {
+		return 0, false
+	}
+
+	return wrapper.Get(value).Uint(), true
+}
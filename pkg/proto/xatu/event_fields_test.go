@@ -0,0 +1,36 @@
+package xatu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestEventSlotAndBlockRoot(t *testing.T) {
+	event := &DecoratedEvent{
+		Meta: &Meta{
+			Client: &ClientMeta{
+				AdditionalData: &ClientMeta_EthV2BeaconBlockDeposit{
+					EthV2BeaconBlockDeposit: &ClientMeta_AdditionalEthV2BeaconBlockDepositData{
+						Block: &BlockIdentifier{
+							Slot: &SlotV2{Number: wrapperspb.UInt64(100)},
+							Root: "0xabc",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	slot, ok := EventSlot(event)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(100), slot)
+
+	root, ok := EventBlockRoot(event)
+	assert.True(t, ok)
+	assert.Equal(t, "0xabc", root)
+
+	_, ok = EventSlot(&DecoratedEvent{})
+	assert.False(t, ok)
+}
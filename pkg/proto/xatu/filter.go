@@ -15,7 +15,8 @@ type EventFilter interface {
 }
 
 type EventFilterConfig struct {
-	EventNames []string `yaml:"eventNames"`
+	EventNames        []string `yaml:"eventNames"`
+	ExcludeEventNames []string `yaml:"excludeEventNames"`
 }
 
 func (f *EventFilterConfig) Validate() error {
@@ -25,6 +26,12 @@ func (f *EventFilterConfig) Validate() error {
 		}
 	}
 
+	for _, eventName := range f.ExcludeEventNames {
+		if _, ok := Event_Name_value[eventName]; !ok {
+			return fmt.Errorf("invalid exclude event name: %s", eventName)
+		}
+	}
+
 	return nil
 }
 
@@ -39,15 +46,24 @@ func NewEventFilter(config *EventFilterConfig) (EventFilter, error) {
 		eventNames[eventName] = struct{}{}
 	}
 
+	excludeEventNames := make(map[string]struct{}, len(config.ExcludeEventNames))
+
+	for _, eventName := range config.ExcludeEventNames {
+		excludeEventNames[eventName] = struct{}{}
+	}
+
 	return &eventFilter{
-		eventNames: eventNames,
+		config:            config,
+		eventNames:        eventNames,
+		excludeEventNames: excludeEventNames,
 	}, nil
 }
 
 type eventFilter struct {
 	config *EventFilterConfig
 
-	eventNames map[string]struct{}
+	eventNames        map[string]struct{}
+	excludeEventNames map[string]struct{}
 }
 
 func (f *eventFilter) EventNames() []string {
@@ -63,6 +79,12 @@ func (f *eventFilter) ShouldBeDropped(event *DecoratedEvent) (bool, error) {
 		return true, errors.New("event.event is nil")
 	}
 
+	if len(f.excludeEventNames) > 0 {
+		if _, ok := f.excludeEventNames[event.Event.Name.String()]; ok {
+			return true, nil
+		}
+	}
+
 	if len(f.eventNames) == 0 {
 		return false, nil
 	}
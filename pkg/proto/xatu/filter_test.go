@@ -127,3 +127,36 @@ func TestEventFilter_AllowEverythingWhenEmpty(t *testing.T) {
 
 	assert.Equal(t, events, filteredEvents)
 }
+
+func TestEventFilter_ExcludeEventNames(t *testing.T) {
+	testConfig := &EventFilterConfig{
+		ExcludeEventNames: []string{Event_BEACON_API_ETH_V1_EVENTS_BLOCK.String()},
+	}
+
+	filter, err := NewEventFilter(testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shouldBeDropped, err := filter.ShouldBeDropped(&DecoratedEvent{
+		Event: &Event{
+			Name: Event_BEACON_API_ETH_V1_EVENTS_BLOCK,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, shouldBeDropped)
+
+	shouldBeDropped, err = filter.ShouldBeDropped(&DecoratedEvent{
+		Event: &Event{
+			Name: Event_BEACON_API_ETH_V1_EVENTS_ATTESTATION,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, shouldBeDropped)
+}
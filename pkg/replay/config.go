@@ -0,0 +1,77 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethpandaops/xatu/pkg/output"
+	"github.com/ethpandaops/xatu/pkg/processor"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	LoggingLevel string `yaml:"logging" default:"info"`
+
+	// Files are newline-delimited JSON files of decorated events, as written by the file output. They are
+	// replayed in order.
+	Files []string `yaml:"files"`
+
+	// Outputs configuration
+	Outputs []output.Config `yaml:"outputs"`
+
+	// EventsPerSecond limits the rate events are replayed. 0 is unlimited.
+	EventsPerSecond int `yaml:"eventsPerSecond" default:"0"`
+
+	// BatchSize is the number of events handed to the outputs at once.
+	BatchSize int `yaml:"batchSize" default:"100"`
+}
+
+func (c *Config) Validate() error {
+	if len(c.Files) == 0 {
+		return errors.New("at least one file is required")
+	}
+
+	if len(c.Outputs) == 0 {
+		return errors.New("at least one output is required")
+	}
+
+	for _, output := range c.Outputs {
+		if err := output.Validate(); err != nil {
+			return fmt.Errorf("invalid output config %s: %w", output.Name, err)
+		}
+	}
+
+	if c.EventsPerSecond < 0 {
+		return errors.New("eventsPerSecond must be greater than or equal to 0")
+	}
+
+	if c.BatchSize < 1 {
+		return errors.New("batchSize must be at least 1")
+	}
+
+	return nil
+}
+
+func (c *Config) CreateSinks(log logrus.FieldLogger) ([]output.Sink, error) {
+	sinks := make([]output.Sink, len(c.Outputs))
+
+	for i, out := range c.Outputs {
+		sink, err := output.NewSink(
+			out.Name,
+			out.SinkType,
+			out.Config,
+			log,
+			out.FilterConfig,
+			// Replayed events are shipped synchronously so a failure stops the replay at a known position.
+			processor.ShippingMethodSync,
+			"",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sinks[i] = output.WithBatching(sink, out.Batch, log)
+	}
+
+	return sinks, nil
+}
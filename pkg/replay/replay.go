@@ -0,0 +1,200 @@
+package replay
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/output"
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// maxLineSize is the longest event line that can be read, which comfortably fits a block with blobs.
+	maxLineSize = 64 * 1024 * 1024
+
+	sinkBusyInterval = 500 * time.Millisecond
+)
+
+// Replay reads decorated events captured by the file output and feeds them back through the outputs.
+type Replay struct {
+	Config *Config
+
+	sinks []output.Sink
+
+	log logrus.FieldLogger
+
+	start    time.Time
+	replayed int
+}
+
+func New(log logrus.FieldLogger, config *Config) (*Replay, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	sinks, err := config.CreateSinks(log)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Replay{
+		Config: config,
+		sinks:  sinks,
+		log:    log.WithField("module", "replay"),
+	}, nil
+}
+
+// Start replays every file and returns once all events have been handed to the outputs, the first failure, or
+// the context is done. The outputs are always stopped so buffered events are flushed.
+func (r *Replay) Start(ctx context.Context) (err error) {
+	for _, sink := range r.sinks {
+		if err := sink.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	defer func() {
+		for _, sink := range r.sinks {
+			if stopErr := sink.Stop(context.Background()); stopErr != nil && err == nil {
+				err = stopErr
+			}
+		}
+	}()
+
+	r.start = time.Now()
+
+	for _, file := range r.Config.Files {
+		if err := r.replayFile(ctx, file); err != nil {
+			return err
+		}
+	}
+
+	r.log.
+		WithField("events", r.replayed).
+		WithField("took", time.Since(r.start)).
+		Info("Replay complete")
+
+	return nil
+}
+
+func (r *Replay) replayFile(ctx context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	log := r.log.WithField("file", path)
+
+	log.Info("Replaying file")
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 1024*1024), maxLineSize)
+
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+
+	batch := make([]*xatu.DecoratedEvent, 0, r.Config.BatchSize)
+	line, batchStart := 0, 1
+
+	for scanner.Scan() {
+		line++
+
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		event := &xatu.DecoratedEvent{}
+		if err := unmarshaler.Unmarshal(scanner.Bytes(), event); err != nil {
+			return fmt.Errorf("%s:%d: failed to parse event: %w", path, line, err)
+		}
+
+		batch = append(batch, event)
+
+		if len(batch) < r.Config.BatchSize {
+			continue
+		}
+
+		if err := r.send(ctx, batch); err != nil {
+			return fmt.Errorf("%s:%d: failed to replay events: %w", path, batchStart, err)
+		}
+
+		batch = batch[:0]
+		batchStart = line + 1
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%s:%d: failed to read file: %w", path, line+1, err)
+	}
+
+	if len(batch) > 0 {
+		if err := r.send(ctx, batch); err != nil {
+			return fmt.Errorf("%s:%d: failed to replay events: %w", path, batchStart, err)
+		}
+	}
+
+	log.WithField("lines", line).Info("Replayed file")
+
+	return nil
+}
+
+func (r *Replay) send(ctx context.Context, events []*xatu.DecoratedEvent) error {
+	if err := r.pace(ctx, len(events)); err != nil {
+		return err
+	}
+
+	for _, sink := range r.sinks {
+		if err := r.handToSink(ctx, sink, events); err != nil {
+			return fmt.Errorf("sink %s: %w", sink.Name(), err)
+		}
+	}
+
+	r.replayed += len(events)
+
+	return nil
+}
+
+// pace waits until the next n events can be replayed without exceeding eventsPerSecond on average.
+func (r *Replay) pace(ctx context.Context, n int) error {
+	if r.Config.EventsPerSecond == 0 {
+		return nil
+	}
+
+	due := r.start.Add(time.Duration(float64(r.replayed+n) / float64(r.Config.EventsPerSecond) * float64(time.Second)))
+
+	wait := time.Until(due)
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+func (r *Replay) handToSink(ctx context.Context, sink output.Sink, events []*xatu.DecoratedEvent) error {
+	for {
+		err := sink.HandleNewDecoratedEvents(ctx, events)
+		if !errors.Is(err, output.ErrSinkBusy) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sinkBusyInterval):
+		}
+	}
+}
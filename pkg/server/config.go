@@ -1,6 +1,11 @@
 package server
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/xatu/pkg/observability"
 	"github.com/ethpandaops/xatu/pkg/server/geoip"
 	"github.com/ethpandaops/xatu/pkg/server/persistence"
 	"github.com/ethpandaops/xatu/pkg/server/service"
@@ -10,6 +15,8 @@ import (
 type Config struct {
 	// The address to listen on.
 	Addr string `yaml:"addr" default:":8080"`
+	// TLS terminates TLS on the gRPC listener.
+	TLS TLSConfig `yaml:"tls"`
 	// PreStopSleepSeconds is the number of seconds to sleep before stopping.
 	// Useful for giving kubernetes time to drain connections.
 	// This sleep will happen after a SIGTERM is received, and will
@@ -17,12 +24,21 @@ type Config struct {
 	// Note: Do not set this to a value greater than the kubernetes
 	// terminationGracePeriodSeconds.
 	PreStopSleepSeconds int `yaml:"preStopSleepSeconds" default:"0"`
+	// ShutdownTimeout bounds how long in-flight gRPC requests are given to complete on shutdown
+	// before their connections are forcibly closed.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" default:"30s"`
 	// MetricsAddr is the address to listen on for metrics.
 	MetricsAddr string `yaml:"metricsAddr" default:":9090"`
 	// PProfAddr is the address to listen on for pprof.
 	PProfAddr *string `yaml:"pprofAddr"`
+	// MaxRecvMsgSize is the maximum size in bytes of a gRPC message the server will accept.
+	MaxRecvMsgSize int `yaml:"maxRecvMsgSize" default:"104857600"`
+	// MaxSendMsgSize is the maximum size in bytes of a gRPC message the server will send.
+	MaxSendMsgSize int `yaml:"maxSendMsgSize" default:"104857600"`
 	// LoggingLevel is the logging level to use.
 	LoggingLevel string `yaml:"logging" default:"info"`
+	// LogFormat is the format logs are written in, text or json.
+	LogFormat observability.LogFormat `yaml:"logFormat" default:"text"`
 
 	// NTP Server to use for clock drift correction
 	NTPServer string `yaml:"ntpServer" default:"time.google.com"`
@@ -39,6 +55,26 @@ type Config struct {
 }
 
 func (c *Config) Validate() error {
+	if err := c.LogFormat.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.TLS.Validate(); err != nil {
+		return fmt.Errorf("invalid tls config: %w", err)
+	}
+
+	if c.ShutdownTimeout <= 0 {
+		return errors.New("shutdownTimeout must be greater than 0")
+	}
+
+	if c.MaxRecvMsgSize <= 0 {
+		return errors.New("maxRecvMsgSize must be greater than 0")
+	}
+
+	if c.MaxSendMsgSize <= 0 {
+		return errors.New("maxSendMsgSize must be greater than 0")
+	}
+
 	if err := c.Services.Validate(); err != nil {
 		return err
 	}
@@ -25,6 +25,29 @@ func (c *Config) Validate() error {
 		return errors.New("geoip provider type is required")
 	}
 
+	if c.Config == nil {
+		return errors.New("geoip provider config is required")
+	}
+
+	switch c.Type {
+	case TypeMaxmind:
+		conf := &maxmind.Config{}
+
+		if err := c.Config.Unmarshal(conf); err != nil {
+			return err
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return fmt.Errorf("invalid maxmind config: %w", err)
+		}
+	default:
+		return fmt.Errorf("geoip provider type %s is unknown", c.Type)
+	}
+
 	return nil
 }
 
@@ -2,12 +2,24 @@ package maxmind
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ethpandaops/xatu/pkg/server/geoip/maxmind/database"
 )
 
 type Config struct {
 	Database *database.Config `yaml:"database"`
+	Cache    CacheConfig      `yaml:"cache"`
+}
+
+type CacheConfig struct {
+	Enabled *bool         `yaml:"enabled" default:"true"`
+	Size    uint64        `yaml:"size" default:"10000"`
+	TTL     time.Duration `yaml:"ttl" default:"1h"`
+}
+
+func (c *CacheConfig) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
 }
 
 func (c *Config) Validate() error {
@@ -19,5 +31,15 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if c.Cache.IsEnabled() {
+		if c.Cache.Size == 0 {
+			return errors.New("cache.size must be greater than 0")
+		}
+
+		if c.Cache.TTL <= 0 {
+			return errors.New("cache.ttl must be greater than 0")
+		}
+	}
+
 	return nil
 }
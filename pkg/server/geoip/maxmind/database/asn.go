@@ -23,7 +23,7 @@ type LookupASN struct {
 func NewASN(config *Config, log logrus.FieldLogger) *ASN {
 	return &ASN{
 		config: config,
-		log:    log.WithField("database", "city"),
+		log:    log.WithField("database", "asn"),
 	}
 }
 
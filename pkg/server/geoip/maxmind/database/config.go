@@ -1,6 +1,12 @@
 package database
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/oschwald/maxminddb-golang"
+)
 
 type Config struct {
 	City string `yaml:"city"`
@@ -8,13 +14,35 @@ type Config struct {
 }
 
 func (c *Config) Validate() error {
-	if c.City == "" {
-		return errors.New("city is required")
+	if c.City == "" && c.ASN == "" {
+		return errors.New("at least one of city or asn is required")
+	}
+
+	if c.City != "" {
+		if err := validateDatabaseFile(c.City); err != nil {
+			return fmt.Errorf("invalid city database: %w", err)
+		}
 	}
 
-	if c.ASN == "" {
-		return errors.New("asn is required")
+	if c.ASN != "" {
+		if err := validateDatabaseFile(c.ASN); err != nil {
+			return fmt.Errorf("invalid asn database: %w", err)
+		}
 	}
 
 	return nil
 }
+
+// validateDatabaseFile checks the file exists and is a readable MaxMind database.
+func validateDatabaseFile(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
@@ -17,7 +17,8 @@ type Maxmind struct {
 
 	log logrus.FieldLogger
 
-	client *ttlcache.Cache[string, string]
+	// cache is nil when caching is disabled
+	cache *ttlcache.Cache[string, *lookup.Result]
 
 	city *database.City
 	asn  *database.ASN
@@ -26,16 +27,35 @@ type Maxmind struct {
 }
 
 func New(config *Config, log logrus.FieldLogger) (*Maxmind, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	nLog := log.WithField("geoip/provider", Type)
 
-	return &Maxmind{
+	m := &Maxmind{
 		config:  config,
 		log:     nLog,
-		client:  ttlcache.New[string, string](),
-		city:    database.NewCity(config.Database, nLog),
-		asn:     database.NewASN(config.Database, nLog),
 		metrics: NewMetrics("xatu_server_geoip_provider"),
-	}, nil
+	}
+
+	if config.Cache.IsEnabled() {
+		m.cache = ttlcache.New[string, *lookup.Result](
+			ttlcache.WithTTL[string, *lookup.Result](config.Cache.TTL),
+			ttlcache.WithCapacity[string, *lookup.Result](config.Cache.Size),
+		)
+	}
+
+	// Either database may be omitted, in which case its fields are left empty in lookup results.
+	if config.Database.City != "" {
+		m.city = database.NewCity(config.Database, nLog)
+	}
+
+	if config.Database.ASN != "" {
+		m.asn = database.NewASN(config.Database, nLog)
+	}
+
+	return m, nil
 }
 
 func (m *Maxmind) Type() string {
@@ -43,18 +63,30 @@ func (m *Maxmind) Type() string {
 }
 
 func (m *Maxmind) Start(ctx context.Context) error {
-	if err := m.city.Start(); err != nil {
-		return err
+	if m.city != nil {
+		if err := m.city.Start(); err != nil {
+			return err
+		}
+	}
+
+	if m.asn != nil {
+		if err := m.asn.Start(); err != nil {
+			return err
+		}
 	}
 
-	if err := m.asn.Start(); err != nil {
-		return err
+	if m.cache != nil {
+		go m.cache.Start()
 	}
 
 	return nil
 }
 
 func (m *Maxmind) Stop(ctx context.Context) error {
+	if m.cache != nil {
+		m.cache.Stop()
+	}
+
 	if m.city != nil {
 		if err := m.city.Stop(); err != nil {
 			return err
@@ -71,6 +103,36 @@ func (m *Maxmind) Stop(ctx context.Context) error {
 }
 
 func (m *Maxmind) LookupIP(ctx context.Context, ip net.IP) (*lookup.Result, error) {
+	if m.cache == nil {
+		return m.lookupIP(ip)
+	}
+
+	key := ip.String()
+
+	if item := m.cache.Get(key); item != nil {
+		m.metrics.AddCacheHit(1, m.Type())
+
+		// Return a copy so callers can't modify the cached result.
+		result := *item.Value()
+
+		return &result, nil
+	}
+
+	m.metrics.AddCacheMiss(1, m.Type())
+
+	result, err := m.lookupIP(ip)
+	if err != nil {
+		return nil, err
+	}
+
+	cached := *result
+
+	m.cache.Set(key, &cached, ttlcache.DefaultTTL)
+
+	return result, nil
+}
+
+func (m *Maxmind) lookupIP(ip net.IP) (*lookup.Result, error) {
 	result := &lookup.Result{}
 
 	if m.city != nil {
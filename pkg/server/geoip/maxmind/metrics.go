@@ -3,7 +3,9 @@ package maxmind
 import "github.com/prometheus/client_golang/prometheus"
 
 type Metrics struct {
-	lookupIPTotal *prometheus.CounterVec
+	lookupIPTotal  *prometheus.CounterVec
+	cacheHitTotal  *prometheus.CounterVec
+	cacheMissTotal *prometheus.CounterVec
 }
 
 func NewMetrics(namespace string) *Metrics {
@@ -13,9 +15,21 @@ func NewMetrics(namespace string) *Metrics {
 			Name:      "lookup_ip_total",
 			Help:      "Total number of lookup by IP requests",
 		}, []string{"type", "status"}),
+		cacheHitTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_hit_total",
+			Help:      "Total number of lookup by IP requests served from the cache",
+		}, []string{"type"}),
+		cacheMissTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_miss_total",
+			Help:      "Total number of lookup by IP requests not found in the cache",
+		}, []string{"type"}),
 	}
 
 	prometheus.MustRegister(m.lookupIPTotal)
+	prometheus.MustRegister(m.cacheHitTotal)
+	prometheus.MustRegister(m.cacheMissTotal)
 
 	return m
 }
@@ -23,3 +37,11 @@ func NewMetrics(namespace string) *Metrics {
 func (m *Metrics) AddLookupIP(count int, providerType, status string) {
 	m.lookupIPTotal.WithLabelValues(providerType, status).Add(float64(count))
 }
+
+func (m *Metrics) AddCacheHit(count int, providerType string) {
+	m.cacheHitTotal.WithLabelValues(providerType).Add(float64(count))
+}
+
+func (m *Metrics) AddCacheMiss(count int, providerType string) {
+	m.cacheMissTotal.WithLabelValues(providerType).Add(float64(count))
+}
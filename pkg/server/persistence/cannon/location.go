@@ -142,6 +142,94 @@ func (l *Location) Marshal(msg *xatu.CannonLocation) error {
 			return err
 		}
 
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE:
+		l.Type = "BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE"
+
+		data := msg.GetEthV2BeaconBlockSyncAggregate()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY:
+		l.Type = "BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY"
+
+		data := msg.GetEthV2BeaconBlockSummary()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD:
+		l.Type = "BEACON_API_ETH_V1_BEACON_BLOCK_REWARD"
+
+		data := msg.GetEthV1BeaconBlockReward()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION:
+		l.Type = "BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION"
+
+		data := msg.GetEthV2BeaconBlockAttestation()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD:
+		l.Type = "BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD"
+
+		data := msg.GetEthV2BeaconBlockExecutionPayload()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY:
+		l.Type = "BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY"
+
+		data := msg.GetEthV1BeaconValidatorActivity()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY:
+		l.Type = "BEACON_API_ETH_V1_PROPOSER_DUTY"
+
+		data := msg.GetEthV1ProposerDuty()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		l.Value = string(b)
+	case xatu.CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE:
+		l.Type = "BEACON_API_ETH_V1_BEACON_COMMITTEE"
+
+		data := msg.GetEthV1BeaconCommittee()
+
+		b, err := protojson.Marshal(data)
+		if err != nil {
+			return err
+		}
+
 		l.Value = string(b)
 	default:
 		return fmt.Errorf("unknown type: %s", msg.Type)
@@ -289,6 +377,110 @@ func (l *Location) Unmarshal() (*xatu.CannonLocation, error) {
 		msg.Data = &xatu.CannonLocation_EthV1BeaconBlobSidecar{
 			EthV1BeaconBlobSidecar: data,
 		}
+	case "BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SYNC_AGGREGATE
+
+		data := &xatu.CannonLocationEthV2BeaconBlockSyncAggregate{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV2BeaconBlockSyncAggregate{
+			EthV2BeaconBlockSyncAggregate: data,
+		}
+	case "BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY
+
+		data := &xatu.CannonLocationEthV2BeaconBlockSummary{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV2BeaconBlockSummary{
+			EthV2BeaconBlockSummary: data,
+		}
+	case "BEACON_API_ETH_V1_BEACON_BLOCK_REWARD":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V1_BEACON_BLOCK_REWARD
+
+		data := &xatu.CannonLocationEthV1BeaconBlockReward{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV1BeaconBlockReward{
+			EthV1BeaconBlockReward: data,
+		}
+	case "BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION
+
+		data := &xatu.CannonLocationEthV2BeaconBlockAttestation{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV2BeaconBlockAttestation{
+			EthV2BeaconBlockAttestation: data,
+		}
+	case "BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD
+
+		data := &xatu.CannonLocationEthV2BeaconBlockExecutionPayload{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV2BeaconBlockExecutionPayload{
+			EthV2BeaconBlockExecutionPayload: data,
+		}
+	case "BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY
+
+		data := &xatu.CannonLocationEthV1BeaconValidatorActivity{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV1BeaconValidatorActivity{
+			EthV1BeaconValidatorActivity: data,
+		}
+	case "BEACON_API_ETH_V1_PROPOSER_DUTY":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V1_PROPOSER_DUTY
+
+		data := &xatu.CannonLocationEthV1ProposerDuty{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV1ProposerDuty{
+			EthV1ProposerDuty: data,
+		}
+	case "BEACON_API_ETH_V1_BEACON_COMMITTEE":
+		msg.Type = xatu.CannonType_BEACON_API_ETH_V1_BEACON_COMMITTEE
+
+		data := &xatu.CannonLocationEthV1BeaconCommittee{}
+
+		err := protojson.Unmarshal([]byte(l.Value), data)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Data = &xatu.CannonLocation_EthV1BeaconCommittee{
+			EthV1BeaconCommittee: data,
+		}
 	default:
 		return nil, fmt.Errorf("unknown type: %s", l.Type)
 	}
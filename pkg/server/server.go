@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	//nolint:blank-imports // Required for grpc.WithCompression
 	_ "google.golang.org/grpc/encoding/gzip"
@@ -172,7 +173,7 @@ func (x *Xatu) stop(ctx context.Context) error {
 	time.Sleep(time.Duration(x.config.PreStopSleepSeconds) * time.Second)
 
 	if x.grpcServer != nil {
-		x.grpcServer.GracefulStop()
+		x.stopGrpcServer()
 	}
 
 	for _, s := range x.services {
@@ -216,20 +217,43 @@ func (x *Xatu) stop(ctx context.Context) error {
 	return nil
 }
 
+// stopGrpcServer stops accepting new connections and waits for in-flight requests to finish, forcing the
+// remaining connections closed once the shutdown timeout elapses.
+func (x *Xatu) stopGrpcServer() {
+	done := make(chan struct{})
+
+	go func() {
+		x.grpcServer.GracefulStop()
+		close(done)
+	}()
+
+	timer := time.NewTimer(x.config.ShutdownTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		x.log.WithField("timeout", x.config.ShutdownTimeout).Warn("Timed out waiting for in-flight requests, forcing the grpc server to stop")
+
+		x.grpcServer.Stop()
+
+		<-done
+	}
+}
+
 func (x *Xatu) startGrpcServer(ctx context.Context) error {
 	lis, err := net.Listen("tcp", x.config.Addr)
 	if err != nil {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
 
-	mb100 := 1024 * 1024 * 100
-
 	grpc_prometheus.EnableHandlingTimeHistogram()
 
 	opts := []grpc.ServerOption{
 		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
 		grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
-		grpc.MaxRecvMsgSize(mb100),
+		grpc.MaxRecvMsgSize(x.config.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(x.config.MaxSendMsgSize),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle:     5 * time.Minute,
 			MaxConnectionAge:      10 * time.Minute,
@@ -238,6 +262,16 @@ func (x *Xatu) startGrpcServer(ctx context.Context) error {
 			Timeout:               15 * time.Second,
 		}),
 	}
+
+	if x.config.TLS.Enabled() {
+		tlsConfig, err := x.config.TLS.ServerTLSConfig()
+		if err != nil {
+			return err
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
 	x.grpcServer = grpc.NewServer(opts...)
 
 	for _, s := range x.services {
@@ -18,11 +18,11 @@ func (c *Config) Validate() error {
 	}
 
 	if err := c.EventIngester.Validate(); err != nil {
-		return err
+		return fmt.Errorf("invalid eventIngester config: %w", err)
 	}
 
 	if err := c.Coordinator.Validate(); err != nil {
-		return err
+		return fmt.Errorf("invalid coordinator config: %w", err)
 	}
 
 	return nil
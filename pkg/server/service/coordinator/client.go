@@ -336,5 +336,25 @@ func (c *Client) UpsertCannonLocation(ctx context.Context, req *xatu.UpsertCanno
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &xatu.UpsertCannonLocationResponse{}, nil
+	rsp := &xatu.UpsertCannonLocationResponse{}
+
+	if !req.Confirm {
+		return rsp, nil
+	}
+
+	stored, err := c.persistence.GetCannonLocationByNetworkIDAndType(ctx, req.Location.NetworkId, req.Location.Type.Enum().String())
+	if err != nil && err != persistence.ErrCannonLocationNotFound {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if stored == nil {
+		return nil, status.Error(codes.NotFound, "location was not stored")
+	}
+
+	rsp.Location, err = stored.Unmarshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return rsp, nil
 }
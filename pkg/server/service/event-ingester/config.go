@@ -10,6 +10,8 @@ type Config struct {
 	Enabled bool `yaml:"enabled" default:"false"`
 	// Outputs is the list of sinks to use.
 	Outputs []output.Config `yaml:"outputs"`
+	// RateLimit throttles the events accepted from each client.
+	RateLimit RateLimitConfig `yaml:"rateLimit"`
 }
 
 func (c *Config) Validate() error {
@@ -21,5 +23,28 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("no outputs configured")
 	}
 
+	// Output names key the sinks' metrics, so a duplicate panics on registration at startup.
+	names := make(map[string]int, len(c.Outputs))
+
+	for i, out := range c.Outputs {
+		if out.Name == "" {
+			return fmt.Errorf("outputs[%d] name is required", i)
+		}
+
+		if first, ok := names[out.Name]; ok {
+			return fmt.Errorf("outputs[%d] and outputs[%d] are both named %q", first, i, out.Name)
+		}
+
+		names[out.Name] = i
+
+		if err := out.Validate(); err != nil {
+			return fmt.Errorf("invalid output config %s: %w", out.Name, err)
+		}
+	}
+
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid rateLimit config: %w", err)
+	}
+
 	return nil
 }
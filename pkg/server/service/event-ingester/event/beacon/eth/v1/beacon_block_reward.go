@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	BeaconBlockRewardType = "BEACON_API_ETH_V1_BEACON_BLOCK_REWARD"
+)
+
+type BeaconBlockReward struct {
+	log   logrus.FieldLogger
+	event *xatu.DecoratedEvent
+}
+
+func NewBeaconBlockReward(log logrus.FieldLogger, event *xatu.DecoratedEvent) *BeaconBlockReward {
+	return &BeaconBlockReward{
+		log:   log.WithField("event", BeaconBlockRewardType),
+		event: event,
+	}
+}
+
+func (b *BeaconBlockReward) Type() string {
+	return BeaconBlockRewardType
+}
+
+func (b *BeaconBlockReward) Validate(ctx context.Context) error {
+	_, ok := b.event.Data.(*xatu.DecoratedEvent_EthV1BeaconBlockReward)
+	if !ok {
+		return errors.New("failed to cast event data")
+	}
+
+	return nil
+}
+
+func (b *BeaconBlockReward) Filter(ctx context.Context) bool {
+	return false
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	BeaconLightClientUpdateType = "BEACON_API_ETH_V1_BEACON_LIGHT_CLIENT_UPDATE"
+)
+
+type BeaconLightClientUpdate struct {
+	log   logrus.FieldLogger
+	event *xatu.DecoratedEvent
+}
+
+func NewBeaconLightClientUpdate(log logrus.FieldLogger, event *xatu.DecoratedEvent) *BeaconLightClientUpdate {
+	return &BeaconLightClientUpdate{
+		log:   log.WithField("event", BeaconLightClientUpdateType),
+		event: event,
+	}
+}
+
+func (b *BeaconLightClientUpdate) Type() string {
+	return BeaconLightClientUpdateType
+}
+
+func (b *BeaconLightClientUpdate) Validate(ctx context.Context) error {
+	_, ok := b.event.Data.(*xatu.DecoratedEvent_EthV1BeaconLightClientUpdate)
+	if !ok {
+		return errors.New("failed to cast event data")
+	}
+
+	return nil
+}
+
+func (b *BeaconLightClientUpdate) Filter(ctx context.Context) bool {
+	return false
+}
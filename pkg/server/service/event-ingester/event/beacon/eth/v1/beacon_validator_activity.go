@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	BeaconValidatorActivityType = "BEACON_API_ETH_V1_BEACON_VALIDATOR_ACTIVITY"
+)
+
+type BeaconValidatorActivity struct {
+	log   logrus.FieldLogger
+	event *xatu.DecoratedEvent
+}
+
+func NewBeaconValidatorActivity(log logrus.FieldLogger, event *xatu.DecoratedEvent) *BeaconValidatorActivity {
+	return &BeaconValidatorActivity{
+		log:   log.WithField("event", BeaconValidatorActivityType),
+		event: event,
+	}
+}
+
+func (b *BeaconValidatorActivity) Type() string {
+	return BeaconValidatorActivityType
+}
+
+func (b *BeaconValidatorActivity) Validate(ctx context.Context) error {
+	_, ok := b.event.Data.(*xatu.DecoratedEvent_EthV1BeaconValidatorActivity)
+	if !ok {
+		return errors.New("failed to cast event data")
+	}
+
+	return nil
+}
+
+func (b *BeaconValidatorActivity) Filter(ctx context.Context) bool {
+	return false
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ProposerDutyType = "BEACON_API_ETH_V1_PROPOSER_DUTY"
+)
+
+type ProposerDuty struct {
+	log   logrus.FieldLogger
+	event *xatu.DecoratedEvent
+}
+
+func NewProposerDuty(log logrus.FieldLogger, event *xatu.DecoratedEvent) *ProposerDuty {
+	return &ProposerDuty{
+		log:   log.WithField("event", ProposerDutyType),
+		event: event,
+	}
+}
+
+func (b *ProposerDuty) Type() string {
+	return ProposerDutyType
+}
+
+func (b *ProposerDuty) Validate(ctx context.Context) error {
+	_, ok := b.event.Data.(*xatu.DecoratedEvent_EthV1ProposerDuty)
+	if !ok {
+		return errors.New("failed to cast event data")
+	}
+
+	return nil
+}
+
+func (b *ProposerDuty) Filter(ctx context.Context) bool {
+	return false
+}
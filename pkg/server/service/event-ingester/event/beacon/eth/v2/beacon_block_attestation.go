@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	BeaconBlockAttestationType = "BEACON_API_ETH_V2_BEACON_BLOCK_ATTESTATION"
+)
+
+type BeaconBlockAttestation struct {
+	log   logrus.FieldLogger
+	event *xatu.DecoratedEvent
+}
+
+func NewBeaconBlockAttestation(log logrus.FieldLogger, event *xatu.DecoratedEvent) *BeaconBlockAttestation {
+	return &BeaconBlockAttestation{
+		log:   log.WithField("event", BeaconBlockAttestationType),
+		event: event,
+	}
+}
+
+func (b *BeaconBlockAttestation) Type() string {
+	return BeaconBlockAttestationType
+}
+
+func (b *BeaconBlockAttestation) Validate(ctx context.Context) error {
+	_, ok := b.event.Data.(*xatu.DecoratedEvent_EthV2BeaconBlockAttestation)
+	if !ok {
+		return errors.New("failed to cast event data")
+	}
+
+	return nil
+}
+
+func (b *BeaconBlockAttestation) Filter(ctx context.Context) bool {
+	return false
+}
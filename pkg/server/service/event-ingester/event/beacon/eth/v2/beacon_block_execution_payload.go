@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	BeaconBlockExecutionPayloadType = "BEACON_API_ETH_V2_BEACON_BLOCK_EXECUTION_PAYLOAD"
+)
+
+type BeaconBlockExecutionPayload struct {
+	log   logrus.FieldLogger
+	event *xatu.DecoratedEvent
+}
+
+func NewBeaconBlockExecutionPayload(log logrus.FieldLogger, event *xatu.DecoratedEvent) *BeaconBlockExecutionPayload {
+	return &BeaconBlockExecutionPayload{
+		log:   log.WithField("event", BeaconBlockExecutionPayloadType),
+		event: event,
+	}
+}
+
+func (b *BeaconBlockExecutionPayload) Type() string {
+	return BeaconBlockExecutionPayloadType
+}
+
+func (b *BeaconBlockExecutionPayload) Validate(ctx context.Context) error {
+	_, ok := b.event.Data.(*xatu.DecoratedEvent_EthV2BeaconBlockExecutionPayload)
+	if !ok {
+		return errors.New("failed to cast event data")
+	}
+
+	return nil
+}
+
+func (b *BeaconBlockExecutionPayload) Filter(ctx context.Context) bool {
+	return false
+}
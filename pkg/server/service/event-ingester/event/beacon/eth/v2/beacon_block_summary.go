@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethpandaops/xatu/pkg/proto/xatu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	BeaconBlockSummaryType = "BEACON_API_ETH_V2_BEACON_BLOCK_SUMMARY"
+)
+
+type BeaconBlockSummary struct {
+	log   logrus.FieldLogger
+	event *xatu.DecoratedEvent
+}
+
+func NewBeaconBlockSummary(log logrus.FieldLogger, event *xatu.DecoratedEvent) *BeaconBlockSummary {
+	return &BeaconBlockSummary{
+		log:   log.WithField("event", BeaconBlockSummaryType),
+		event: event,
+	}
+}
+
+func (b *BeaconBlockSummary) Type() string {
+	return BeaconBlockSummaryType
+}
+
+func (b *BeaconBlockSummary) Validate(ctx context.Context) error {
+	_, ok := b.event.Data.(*xatu.DecoratedEvent_EthV2BeaconBlockSummary)
+	if !ok {
+		return errors.New("failed to cast event data")
+	}
+
+	return nil
+}
+
+func (b *BeaconBlockSummary) Filter(ctx context.Context) bool {
+	return false
+}
@@ -52,6 +52,14 @@ const (
 	TypeBlockprintBlockClassification           Type = blockprint.BlockClassificationType
 	TypeBeaconETHV1EventsBlobSidecar            Type = v1.EventsBlobSidecarType
 	TypeBeaconETHV1BeaconBlobSidecar            Type = v1.BeaconBlobSidecarType
+	TypeBeaconEthV2BeaconSyncAggregate          Type = v2.BeaconBlockSyncAggregateType
+	TypeBeaconEthV2BeaconBlockSummary           Type = v2.BeaconBlockSummaryType
+	TypeBeaconETHV1BeaconBlockReward            Type = v1.BeaconBlockRewardType
+	TypeBeaconEthV2BeaconBlockAttestation       Type = v2.BeaconBlockAttestationType
+	TypeBeaconEthV2BeaconBlockExecutionPayload  Type = v2.BeaconBlockExecutionPayloadType
+	TypeBeaconEthV1BeaconValidatorActivity      Type = v1.BeaconValidatorActivityType
+	TypeBeaconEthV1BeaconLightClientUpdate      Type = v1.BeaconLightClientUpdateType
+	TypeBeaconEthV1ProposerDuty                 Type = v1.ProposerDutyType
 )
 
 type Event interface {
@@ -135,6 +143,22 @@ func New(eventType Type, log logrus.FieldLogger, event *xatu.DecoratedEvent, cac
 		return v1.NewEventsBlobSidecar(log, event), nil
 	case TypeBeaconETHV1BeaconBlobSidecar:
 		return v1.NewBeaconBlobSidecar(log, event), nil
+	case TypeBeaconEthV2BeaconSyncAggregate:
+		return v2.NewBeaconBlockSyncAggregate(log, event), nil
+	case TypeBeaconEthV2BeaconBlockSummary:
+		return v2.NewBeaconBlockSummary(log, event), nil
+	case TypeBeaconETHV1BeaconBlockReward:
+		return v1.NewBeaconBlockReward(log, event), nil
+	case TypeBeaconEthV2BeaconBlockAttestation:
+		return v2.NewBeaconBlockAttestation(log, event), nil
+	case TypeBeaconEthV2BeaconBlockExecutionPayload:
+		return v2.NewBeaconBlockExecutionPayload(log, event), nil
+	case TypeBeaconEthV1BeaconValidatorActivity:
+		return v1.NewBeaconValidatorActivity(log, event), nil
+	case TypeBeaconEthV1BeaconLightClientUpdate:
+		return v1.NewBeaconLightClientUpdate(log, event), nil
+	case TypeBeaconEthV1ProposerDuty:
+		return v1.NewProposerDuty(log, event), nil
 
 	default:
 		return nil, fmt.Errorf("event type %s is unknown", eventType)
@@ -26,6 +26,9 @@ type Ingester struct {
 	config  *Config
 	handler *Handler
 
+	// rateLimiter is nil when rate limiting is disabled
+	rateLimiter *clientRateLimiter
+
 	sinks []output.Sink
 }
 
@@ -43,6 +46,10 @@ func NewIngester(ctx context.Context, log logrus.FieldLogger, conf *Config, cloc
 
 	e.sinks = sinks
 
+	if conf.RateLimit.Enabled {
+		e.rateLimiter = newClientRateLimiter(&conf.RateLimit)
+	}
+
 	return e, nil
 }
 
@@ -51,12 +58,20 @@ func (e *Ingester) Start(ctx context.Context, grpcServer *grpc.Server) error {
 
 	xatu.RegisterEventIngesterServer(grpcServer, e)
 
+	if e.rateLimiter != nil {
+		e.rateLimiter.Start()
+	}
+
 	return nil
 }
 
 func (e *Ingester) Stop(ctx context.Context) error {
 	e.log.Info("Stopping module")
 
+	if e.rateLimiter != nil {
+		e.rateLimiter.Stop()
+	}
+
 	for _, sink := range e.sinks {
 		if err := sink.Stop(ctx); err != nil {
 			return status.Error(codes.Internal, err.Error())
@@ -69,6 +84,10 @@ func (e *Ingester) Stop(ctx context.Context) error {
 func (e *Ingester) CreateEvents(ctx context.Context, req *xatu.CreateEventsRequest) (*xatu.CreateEventsResponse, error) {
 	e.log.WithField("events", len(req.Events)).Debug("Received batch of events")
 
+	if err := e.checkRateLimit(req.Events); err != nil {
+		return nil, err
+	}
+
 	// TODO(sam.calder-mason): Derive client id/name from the request jwt
 	clientID := "unknown"
 
@@ -86,6 +105,34 @@ func (e *Ingester) CreateEvents(ctx context.Context, req *xatu.CreateEventsReque
 	return &xatu.CreateEventsResponse{}, nil
 }
 
+// checkRateLimit returns a ResourceExhausted status, which clients treat as retryable, when any client
+// in the batch is over its rate limit.
+func (e *Ingester) checkRateLimit(events []*xatu.DecoratedEvent) error {
+	if e.rateLimiter == nil {
+		return nil
+	}
+
+	counts := make(map[string]int)
+
+	for _, event := range events {
+		clientID := event.GetMeta().GetClient().GetId()
+		if clientID == "" {
+			clientID = "unknown"
+		}
+
+		counts[clientID]++
+	}
+
+	clientID, ok := e.rateLimiter.AllowAll(counts)
+	if ok {
+		return nil
+	}
+
+	e.handler.metrics.AddRateLimited(counts[clientID], e.rateLimiter.MetricsLabel(clientID))
+
+	return status.Errorf(codes.ResourceExhausted, "client %s exceeded the rate limit of %v events per second", clientID, e.config.RateLimit.EventsPerSecond)
+}
+
 func (e *Ingester) CreateSinks() ([]output.Sink, error) {
 	sinks := make([]output.Sink, len(e.config.Outputs))
 
@@ -96,12 +143,13 @@ func (e *Ingester) CreateSinks() ([]output.Sink, error) {
 			e.log,
 			out.FilterConfig,
 			processor.ShippingMethodSync,
+			"",
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		sinks[i] = sink
+		sinks[i] = output.WithBatching(sink, out.Batch, e.log)
 	}
 
 	return sinks, nil
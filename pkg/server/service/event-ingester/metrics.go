@@ -3,7 +3,9 @@ package eventingester
 import "github.com/prometheus/client_golang/prometheus"
 
 type Metrics struct {
-	decoratedEventsTotal *prometheus.CounterVec
+	decoratedEventsTotal     *prometheus.CounterVec
+	rateLimitedRequestsTotal *prometheus.CounterVec
+	rateLimitedEventsTotal   *prometheus.CounterVec
 }
 
 func NewMetrics(namespace string) *Metrics {
@@ -13,9 +15,21 @@ func NewMetrics(namespace string) *Metrics {
 			Name:      "decorated_events_received_total",
 			Help:      "Total number of decorated events received",
 		}, []string{"event", "sentry_id"}),
+		rateLimitedRequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rate_limited_requests_total",
+			Help:      "Total number of requests rejected because the client exceeded its rate limit",
+		}, []string{"client_id"}),
+		rateLimitedEventsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rate_limited_events_total",
+			Help:      "Total number of events rejected because the client exceeded its rate limit",
+		}, []string{"client_id"}),
 	}
 
 	prometheus.MustRegister(m.decoratedEventsTotal)
+	prometheus.MustRegister(m.rateLimitedRequestsTotal)
+	prometheus.MustRegister(m.rateLimitedEventsTotal)
 
 	return m
 }
@@ -23,3 +37,8 @@ func NewMetrics(namespace string) *Metrics {
 func (m *Metrics) AddDecoratedEventReceived(count int, event, sentryID string) {
 	m.decoratedEventsTotal.WithLabelValues(event, sentryID).Add(float64(count))
 }
+
+func (m *Metrics) AddRateLimited(events int, clientID string) {
+	m.rateLimitedRequestsTotal.WithLabelValues(clientID).Inc()
+	m.rateLimitedEventsTotal.WithLabelValues(clientID).Add(float64(events))
+}
@@ -0,0 +1,164 @@
+package eventingester
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+)
+
+type RateLimitConfig struct {
+	Enabled bool `yaml:"enabled" default:"false"`
+	// EventsPerSecond is the sustained number of events accepted per client.
+	EventsPerSecond float64 `yaml:"eventsPerSecond" default:"1000"`
+	// Burst is the number of events a client may send at once after being idle.
+	Burst int `yaml:"burst" default:"10000"`
+	// IdleTimeout is how long a client's bucket is kept after its last request.
+	IdleTimeout time.Duration `yaml:"idleTimeout" default:"10m"`
+	// MaxMetricsClients is the number of distinct client IDs that get their own rate limit metrics series.
+	// Client IDs are chosen by the clients, so any beyond this are reported as "other".
+	MaxMetricsClients int `yaml:"maxMetricsClients" default:"100"`
+}
+
+func (c *RateLimitConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.EventsPerSecond <= 0 {
+		return errors.New("eventsPerSecond must be greater than 0")
+	}
+
+	if c.Burst <= 0 {
+		return errors.New("burst must be greater than 0")
+	}
+
+	if c.IdleTimeout <= 0 {
+		return errors.New("idleTimeout must be greater than 0")
+	}
+
+	if c.MaxMetricsClients < 0 {
+		return errors.New("maxMetricsClients must be greater than or equal to 0")
+	}
+
+	return nil
+}
+
+// clientRateLimiter keeps a token bucket per client. Buckets are dropped once a client has been idle for
+// the configured timeout, at which point the client is back to a full bucket.
+type clientRateLimiter struct {
+	config  *RateLimitConfig
+	buckets *ttlcache.Cache[string, *tokenBucket]
+
+	// mu makes checking and consuming every client's tokens in AllowAll atomic.
+	mu sync.Mutex
+
+	labelsMu sync.Mutex
+	labels   map[string]struct{}
+}
+
+func newClientRateLimiter(config *RateLimitConfig) *clientRateLimiter {
+	return &clientRateLimiter{
+		config: config,
+		buckets: ttlcache.New[string, *tokenBucket](
+			ttlcache.WithTTL[string, *tokenBucket](config.IdleTimeout),
+		),
+		labels: make(map[string]struct{}),
+	}
+}
+
+func (l *clientRateLimiter) Start() {
+	go l.buckets.Start()
+}
+
+func (l *clientRateLimiter) Stop() {
+	l.buckets.Stop()
+}
+
+// AllowAll reports whether every client may submit its number of events. Tokens are only consumed when all of
+// them are allowed, so clients aren't charged for a batch that is rejected because of another client. The first
+// client over its limit is returned otherwise.
+func (l *clientRateLimiter) AllowAll(counts map[string]int) (string, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	buckets := make(map[string]*tokenBucket, len(counts))
+
+	for clientID, n := range counts {
+		item, _ := l.buckets.GetOrSet(clientID, newTokenBucket(l.config.EventsPerSecond, float64(l.config.Burst)))
+
+		bucket := item.Value()
+		if !bucket.has(now, float64(n)) {
+			return clientID, false
+		}
+
+		buckets[clientID] = bucket
+	}
+
+	for clientID, bucket := range buckets {
+		bucket.take(float64(counts[clientID]))
+	}
+
+	return "", true
+}
+
+// MetricsLabel returns the label the client's rate limit metrics are recorded under. The first
+// MaxMetricsClients clients get their own label, any others share "other".
+func (l *clientRateLimiter) MetricsLabel(clientID string) string {
+	l.labelsMu.Lock()
+	defer l.labelsMu.Unlock()
+
+	if _, ok := l.labels[clientID]; ok {
+		return clientID
+	}
+
+	if len(l.labels) >= l.config.MaxMetricsClients {
+		return "other"
+	}
+
+	l.labels[clientID] = struct{}{}
+
+	return clientID
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst float64) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// has refills the bucket up to now and reports whether n tokens are available.
+func (b *tokenBucket) has(now time.Time, n float64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+
+	// A batch larger than the burst is let through once the bucket is full, leaving the client in deficit
+	// until it refills, so oversized batches are slowed rather than rejected forever.
+	return b.tokens >= math.Min(n, b.burst)
+}
+
+func (b *tokenBucket) take(n float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens -= n
+}
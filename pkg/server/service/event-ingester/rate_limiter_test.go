@@ -0,0 +1,135 @@
+package eventingester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketHas(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name     string
+		rate     float64
+		burst    float64
+		taken    float64
+		elapsed  time.Duration
+		n        float64
+		expected bool
+	}{
+		{name: "full bucket", rate: 10, burst: 100, n: 100, expected: true},
+		{name: "not enough tokens", rate: 10, burst: 100, taken: 95, n: 10, expected: false},
+		{name: "refilled since last take", rate: 10, burst: 100, taken: 95, elapsed: time.Second, n: 10, expected: true},
+		{name: "refill capped at burst", rate: 10, burst: 100, taken: 50, elapsed: time.Hour, n: 101, expected: true},
+		{name: "oversized batch on a full bucket", rate: 10, burst: 100, n: 1000, expected: true},
+		{name: "oversized batch on a partial bucket", rate: 10, burst: 100, taken: 1, n: 1000, expected: false},
+		{name: "in deficit", rate: 10, burst: 100, taken: 200, elapsed: 5 * time.Second, n: 1, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket := newTokenBucket(tt.rate, tt.burst)
+			bucket.last = start
+
+			bucket.take(tt.taken)
+
+			assert.Equal(t, tt.expected, bucket.has(start.Add(tt.elapsed), tt.n))
+		})
+	}
+}
+
+func TestClientRateLimiterAllowAll(t *testing.T) {
+	tests := []struct {
+		name            string
+		before          map[string]int
+		counts          map[string]int
+		expectedAllowed bool
+		expectedClient  string
+		// after is checked once counts has been submitted, to see which clients were charged.
+		after        map[string]int
+		afterAllowed bool
+	}{
+		{
+			name:            "every client within its limit",
+			counts:          map[string]int{"a": 5, "b": 5},
+			expectedAllowed: true,
+			after:           map[string]int{"a": 6},
+			afterAllowed:    false,
+		},
+		{
+			name:            "one client over its limit",
+			before:          map[string]int{"b": 8},
+			counts:          map[string]int{"a": 5, "b": 5},
+			expectedAllowed: false,
+			expectedClient:  "b",
+			after:           map[string]int{"a": 10},
+			afterAllowed:    true,
+		},
+		{
+			name:            "clients don't share a bucket",
+			before:          map[string]int{"a": 10},
+			counts:          map[string]int{"b": 10},
+			expectedAllowed: true,
+			after:           map[string]int{"a": 1},
+			afterAllowed:    false,
+		},
+		{
+			name:            "no events",
+			counts:          map[string]int{},
+			expectedAllowed: true,
+			after:           map[string]int{"a": 10},
+			afterAllowed:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A negligible rate keeps the buckets from refilling while the test runs.
+			limiter := newClientRateLimiter(&RateLimitConfig{
+				EventsPerSecond: 0.0001,
+				Burst:           10,
+				IdleTimeout:     time.Minute,
+			})
+
+			if tt.before != nil {
+				_, allowed := limiter.AllowAll(tt.before)
+				assert.True(t, allowed)
+			}
+
+			clientID, allowed := limiter.AllowAll(tt.counts)
+
+			assert.Equal(t, tt.expectedAllowed, allowed)
+			assert.Equal(t, tt.expectedClient, clientID)
+
+			_, allowed = limiter.AllowAll(tt.after)
+
+			assert.Equal(t, tt.afterAllowed, allowed)
+		})
+	}
+}
+
+func TestClientRateLimiterMetricsLabel(t *testing.T) {
+	limiter := newClientRateLimiter(&RateLimitConfig{
+		EventsPerSecond:   1,
+		Burst:             1,
+		IdleTimeout:       time.Minute,
+		MaxMetricsClients: 2,
+	})
+
+	tests := []struct {
+		clientID string
+		expected string
+	}{
+		{clientID: "a", expected: "a"},
+		{clientID: "b", expected: "b"},
+		{clientID: "c", expected: "other"},
+		{clientID: "a", expected: "a"},
+		{clientID: "d", expected: "other"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, limiter.MetricsLabel(tt.clientID), tt.clientID)
+	}
+}
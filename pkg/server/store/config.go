@@ -22,6 +22,12 @@ func (c *Config) Validate() error {
 		return errors.New("cache type is required")
 	}
 
+	switch c.Type {
+	case TypeMemory, TypeRedisServer, TypeRedisCluster:
+	default:
+		return fmt.Errorf("cache type %s is unknown", c.Type)
+	}
+
 	return nil
 }
 
@@ -23,11 +23,32 @@ type Cluster struct {
 }
 
 func New(config *Config, log logrus.FieldLogger) (*Cluster, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	options, err := redis.ParseClusterURL(config.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	// Cluster pool settings apply per node.
+	if config.PoolSize > 0 {
+		options.PoolSize = config.PoolSize
+	}
+
+	if config.MinIdleConns > 0 {
+		options.MinIdleConns = config.MinIdleConns
+	}
+
+	if config.ConnMaxIdleTime > 0 {
+		options.ConnMaxIdleTime = config.ConnMaxIdleTime
+	}
+
+	if config.PoolTimeout > 0 {
+		options.PoolTimeout = config.PoolTimeout
+	}
+
 	return &Cluster{
 		config:  config,
 		log:     log.WithField("store/cache", Type),
@@ -2,11 +2,17 @@ package cluster
 
 import (
 	"errors"
+	"time"
 )
 
 type Config struct {
 	Address string `yaml:"address" default:"redis://localhost:6379/0"`
 	Prefix  string `yaml:"prefix" default:"xatu"`
+	// Pool settings override the values parsed from the address when set.
+	PoolSize        int           `yaml:"poolSize"`
+	MinIdleConns    int           `yaml:"minIdleConns"`
+	ConnMaxIdleTime time.Duration `yaml:"connMaxIdleTime"`
+	PoolTimeout     time.Duration `yaml:"poolTimeout"`
 }
 
 func (c *Config) Validate() error {
@@ -14,5 +20,17 @@ func (c *Config) Validate() error {
 		return errors.New("address is required")
 	}
 
+	if c.PoolSize < 0 {
+		return errors.New("poolSize must not be negative")
+	}
+
+	if c.MinIdleConns < 0 {
+		return errors.New("minIdleConns must not be negative")
+	}
+
+	if c.PoolSize > 0 && c.MinIdleConns > c.PoolSize {
+		return errors.New("minIdleConns must not be greater than poolSize")
+	}
+
 	return nil
 }
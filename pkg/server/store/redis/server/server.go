@@ -23,11 +23,31 @@ type Server struct {
 }
 
 func New(config *Config, log logrus.FieldLogger) (*Server, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	options, err := redis.ParseURL(config.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	if config.PoolSize > 0 {
+		options.PoolSize = config.PoolSize
+	}
+
+	if config.MinIdleConns > 0 {
+		options.MinIdleConns = config.MinIdleConns
+	}
+
+	if config.ConnMaxIdleTime > 0 {
+		options.ConnMaxIdleTime = config.ConnMaxIdleTime
+	}
+
+	if config.PoolTimeout > 0 {
+		options.PoolTimeout = config.PoolTimeout
+	}
+
 	return &Server{
 		config:  config,
 		log:     log.WithField("store/cache", Type),
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+type TLSConfig struct {
+	// CertificatePath and KeyPath enable TLS on the gRPC listener when set.
+	CertificatePath string `yaml:"certificatePath"`
+	KeyPath         string `yaml:"keyPath"`
+	// ClientCACertificatePath enables mutual TLS. Clients must present a certificate signed by this CA.
+	ClientCACertificatePath string `yaml:"clientCaCertificatePath"`
+}
+
+func (c *TLSConfig) Enabled() bool {
+	return c.CertificatePath != ""
+}
+
+func (c *TLSConfig) Validate() error {
+	if (c.CertificatePath == "") != (c.KeyPath == "") {
+		return errors.New("certificatePath and keyPath must be set together")
+	}
+
+	if c.ClientCACertificatePath != "" && !c.Enabled() {
+		return errors.New("clientCaCertificatePath requires certificatePath and keyPath")
+	}
+
+	return nil
+}
+
+func (c *TLSConfig) ServerTLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertificatePath, c.KeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if c.ClientCACertificatePath != "" {
+		ca, err := os.ReadFile(c.ClientCACertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client ca certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse client ca certificate %s", c.ClientCACertificatePath)
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}